@@ -0,0 +1,41 @@
+package examples
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMockAliasedType(t *testing.T) {
+	mock, assertMock := (&KVMocker{}).Describe().
+		Get().Takes("foo").Returns(42, nil).Times(1).
+		Mock()
+	defer assertMock(t)
+
+	var kv KV = mock
+	v, err := kv.Get("foo")
+	assert.NoError(t, err)
+	assert.Equal(t, 42, v)
+}
+
+func TestMockAliasedFuncLiteral(t *testing.T) {
+	errBad := errors.New("bad key")
+	mock, assertMock := (&HandlerMocker{}).Describe().
+		Func().Takes("foo").Returns(errBad).Times(1).
+		Mock()
+	defer assertMock(t)
+
+	var handle Handler = mock
+	assert.Equal(t, errBad, handle("foo"))
+}
+
+func TestMockAliasedInterfaceLiteral(t *testing.T) {
+	var getter Getter = (&GetterMocker{
+		Get: func(key string) (int, error) { return len(key), nil },
+	}).Mock()
+
+	v, err := getter.Get("foo")
+	assert.NoError(t, err)
+	assert.Equal(t, 3, v)
+}
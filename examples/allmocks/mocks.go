@@ -0,0 +1,354 @@
+// Code generated by github.com/tcard/make.go.mock. DO NOT EDIT.
+
+package allmocks
+
+import (
+	"os"
+
+	"github.com/tcard/make.go.mock/examples"
+)
+
+// AnyMocker builds mocks for type Any.
+//
+// Its fields match the original type's methods. Set those you expect to be
+// called, then call the Mock method to get a mock that implements the original
+// type.
+//
+// If the original type was a function, it is mapped to field Func.
+type AnyMocker struct{}
+
+// Mock returns a mock for Any that calls the functions
+// defined as struct fields in the receiver.
+func (m *AnyMocker) Mock() AnyMock {
+	return _makegomock_AnyMockFromMocker{m}
+}
+
+type _makegomock_AnyMockFromMocker struct {
+	m *AnyMocker
+}
+
+// AnyMock is a mock with the same underlying type as Any.
+//
+// It is copied from the original just to avoid introducing a dependency on
+// Any's package.
+type AnyMock interface{}
+
+// BigClientMocker builds mocks for type BigClient.
+//
+// Its fields match the original type's methods. Set those you expect to be
+// called, then call the Mock method to get a mock that implements the original
+// type.
+//
+// If the original type was a function, it is mapped to field Func.
+type BigClientMocker struct {
+	Delete func(key string) (r0 error)
+	Get    func(key string) (r0 string, r1 error)
+	List   func(prefix string) (r0 []string, r1 error)
+	Put    func(key string, value string) (r0 error)
+	Watch  func(key string, f func(value string)) (stop func())
+}
+
+// Mock returns a mock for BigClient that calls the functions
+// defined as struct fields in the receiver.
+func (m *BigClientMocker) Mock() BigClientMock {
+	return _makegomock_BigClientMockFromMocker{m}
+}
+
+type _makegomock_BigClientMockFromMocker struct {
+	m *BigClientMocker
+}
+
+func (m _makegomock_BigClientMockFromMocker) Delete(key string) (r0 error) {
+	return m.m.Delete(key)
+}
+
+func (m _makegomock_BigClientMockFromMocker) Get(key string) (r0 string, r1 error) {
+	return m.m.Get(key)
+}
+
+func (m _makegomock_BigClientMockFromMocker) List(prefix string) (r0 []string, r1 error) {
+	return m.m.List(prefix)
+}
+
+func (m _makegomock_BigClientMockFromMocker) Put(key string, value string) (r0 error) {
+	return m.m.Put(key, value)
+}
+
+func (m _makegomock_BigClientMockFromMocker) Watch(key string, f func(value string)) (stop func()) {
+	return m.m.Watch(key, f)
+}
+
+// BigClientMock is a mock with the same underlying type as BigClient.
+//
+// It is copied from the original just to avoid introducing a dependency on
+// BigClient's package.
+type BigClientMock interface {
+	Delete(key string) (r0 error)
+	Get(key string) (r0 string, r1 error)
+	List(prefix string) (r0 []string, r1 error)
+	Put(key string, value string) (r0 error)
+	Watch(key string, f func(value string)) (stop func())
+}
+
+// EmbeddedMocker builds mocks for type Embedded.
+//
+// Its fields match the original type's methods. Set those you expect to be
+// called, then call the Mock method to get a mock that implements the original
+// type.
+//
+// If the original type was a function, it is mapped to field Func.
+type EmbeddedMocker struct {
+	EmbeddedMethod func()
+}
+
+// Mock returns a mock for Embedded that calls the functions
+// defined as struct fields in the receiver.
+func (m *EmbeddedMocker) Mock() EmbeddedMock {
+	return _makegomock_EmbeddedMockFromMocker{m}
+}
+
+type _makegomock_EmbeddedMockFromMocker struct {
+	m *EmbeddedMocker
+}
+
+func (m _makegomock_EmbeddedMockFromMocker) EmbeddedMethod() {
+	m.m.EmbeddedMethod()
+}
+
+// EmbeddedMock is a mock with the same underlying type as Embedded.
+//
+// It is copied from the original just to avoid introducing a dependency on
+// Embedded's package.
+type EmbeddedMock interface {
+	EmbeddedMethod()
+}
+
+// KeyValuesRepositoryMocker builds mocks for type KeyValuesRepository.
+//
+// Its fields match the original type's methods. Set those you expect to be
+// called, then call the Mock method to get a mock that implements the original
+// type.
+//
+// If the original type was a function, it is mapped to field Func.
+type KeyValuesRepositoryMocker struct {
+	Get func(key string) (r0 int, r1 error)
+	Put func(key string, value int) (r0 error)
+}
+
+// Mock returns a mock for KeyValuesRepository that calls the functions
+// defined as struct fields in the receiver.
+func (m *KeyValuesRepositoryMocker) Mock() KeyValuesRepositoryMock {
+	return _makegomock_KeyValuesRepositoryMockFromMocker{m}
+}
+
+type _makegomock_KeyValuesRepositoryMockFromMocker struct {
+	m *KeyValuesRepositoryMocker
+}
+
+func (m _makegomock_KeyValuesRepositoryMockFromMocker) Get(key string) (r0 int, r1 error) {
+	return m.m.Get(key)
+}
+
+func (m _makegomock_KeyValuesRepositoryMockFromMocker) Put(key string, value int) (r0 error) {
+	return m.m.Put(key, value)
+}
+
+// KeyValuesRepositoryMock is a mock with the same underlying type as KeyValuesRepository.
+//
+// It is copied from the original just to avoid introducing a dependency on
+// KeyValuesRepository's package.
+type KeyValuesRepositoryMock interface {
+	Get(key string) (r0 int, r1 error)
+	Put(key string, value int) (r0 error)
+}
+
+// LoggerMocker builds mocks for type Logger.
+//
+// Its fields match the original type's methods. Set those you expect to be
+// called, then call the Mock method to get a mock that implements the original
+// type.
+//
+// If the original type was a function, it is mapped to field Func.
+type LoggerMocker struct {
+	Log func(msg string)
+}
+
+// Mock returns a mock for Logger that calls the functions
+// defined as struct fields in the receiver.
+func (m *LoggerMocker) Mock() LoggerMock {
+	return _makegomock_LoggerMockFromMocker{m}
+}
+
+type _makegomock_LoggerMockFromMocker struct {
+	m *LoggerMocker
+}
+
+func (m _makegomock_LoggerMockFromMocker) Log(msg string) {
+	m.m.Log(msg)
+}
+
+// LoggerMock is a mock with the same underlying type as Logger.
+//
+// It is copied from the original just to avoid introducing a dependency on
+// Logger's package.
+type LoggerMock interface {
+	Log(msg string)
+}
+
+// MapperMocker builds mocks for type Mapper.
+//
+// Its fields match the original type's methods. Set those you expect to be
+// called, then call the Mock method to get a mock that implements the original
+// type.
+//
+// If the original type was a function, it is mapped to field Func.
+type MapperMocker[T any, U ~string] struct {
+	Func func(a0 T) (r0 U, r1 error)
+}
+
+// Mock returns a mock for Mapper that calls the functions
+// defined as struct fields in the receiver.
+func (m *MapperMocker[T, U]) Mock() MapperMock[T, U] {
+	return m.Func
+}
+
+// MapperMock is a mock with the same underlying type as Mapper.
+//
+// It is copied from the original just to avoid introducing a dependency on
+// Mapper's package.
+type MapperMock[T any, U ~string] func(T) (U, error)
+
+// MyFuncMocker builds mocks for type MyFunc.
+//
+// Its fields match the original type's methods. Set those you expect to be
+// called, then call the Mock method to get a mock that implements the original
+// type.
+//
+// If the original type was a function, it is mapped to field Func.
+type MyFuncMocker struct {
+	Func func(a int, b int, c int, x bool, multi ...examples.MyStruct) (ok bool, err error)
+}
+
+// Mock returns a mock for MyFunc that calls the functions
+// defined as struct fields in the receiver.
+func (m *MyFuncMocker) Mock() MyFuncMock {
+	return m.Func
+}
+
+// MyFuncMock is a mock with the same underlying type as MyFunc.
+//
+// It is copied from the original just to avoid introducing a dependency on
+// MyFunc's package.
+type MyFuncMock func(a int, b int, c int, x bool, multi ...examples.MyStruct) (ok bool, err error)
+
+// MyInterfaceMocker builds mocks for type MyInterface.
+//
+// Its fields match the original type's methods. Set those you expect to be
+// called, then call the Mock method to get a mock that implements the original
+// type.
+//
+// If the original type was a function, it is mapped to field Func.
+type MyInterfaceMocker struct {
+	// Boring takes nothing and returns nothing.
+	Boring         func()
+	EmbeddedMethod func()
+	// ReturnSomethingAtLeast returns an int, which is something at least.
+	ReturnSomethingAtLeast func() (r0 int)
+	// ShouldBeFun takes some complex types, and a variadic argument. Its
+	// parameters are unnamed, so they get synthetic names in the mock.
+	ShouldBeFun func(a0 int, a1 map[string]map[examples.MyStruct]bool, a2 ...chan<- <-chan struct{}) (r0 int, r1 error)
+	// StdSomething takes a type from the standard library.
+	StdSomething func(f *os.File, ints ...int) (named bool)
+}
+
+// Mock returns a mock for MyInterface that calls the functions
+// defined as struct fields in the receiver.
+func (m *MyInterfaceMocker) Mock() MyInterfaceMock {
+	return _makegomock_MyInterfaceMockFromMocker{m}
+}
+
+type _makegomock_MyInterfaceMockFromMocker struct {
+	m *MyInterfaceMocker
+}
+
+func (m _makegomock_MyInterfaceMockFromMocker) Boring() {
+	m.m.Boring()
+}
+
+func (m _makegomock_MyInterfaceMockFromMocker) EmbeddedMethod() {
+	m.m.EmbeddedMethod()
+}
+
+func (m _makegomock_MyInterfaceMockFromMocker) ReturnSomethingAtLeast() (r0 int) {
+	return m.m.ReturnSomethingAtLeast()
+}
+
+func (m _makegomock_MyInterfaceMockFromMocker) ShouldBeFun(a0 int, a1 map[string]map[examples.MyStruct]bool, a2 ...chan<- <-chan struct{}) (r0 int, r1 error) {
+	return m.m.ShouldBeFun(a0, a1, a2...)
+}
+
+func (m _makegomock_MyInterfaceMockFromMocker) StdSomething(f *os.File, ints ...int) (named bool) {
+	return m.m.StdSomething(f, ints...)
+}
+
+// MyInterfaceMock is a mock with the same underlying type as MyInterface.
+//
+// It is copied from the original just to avoid introducing a dependency on
+// MyInterface's package.
+type MyInterfaceMock interface {
+	// Boring takes nothing and returns nothing.
+	Boring()
+	EmbeddedMethod()
+	// ReturnSomethingAtLeast returns an int, which is something at least.
+	ReturnSomethingAtLeast() (r0 int)
+	// ShouldBeFun takes some complex types, and a variadic argument. Its
+	// parameters are unnamed, so they get synthetic names in the mock.
+	ShouldBeFun(a0 int, a1 map[string]map[examples.MyStruct]bool, a2 ...chan<- <-chan struct{}) (r0 int, r1 error)
+	// StdSomething takes a type from the standard library.
+	StdSomething(f *os.File, ints ...int) (named bool)
+}
+
+// RepositoryMocker builds mocks for type Repository.
+//
+// Its fields match the original type's methods. Set those you expect to be
+// called, then call the Mock method to get a mock that implements the original
+// type.
+//
+// If the original type was a function, it is mapped to field Func.
+type RepositoryMocker[K comparable, V any] struct {
+	Get  func(key K) (r0 V, r1 error)
+	Keys func() (r0 []K)
+	Put  func(key K, value V) (r0 error)
+}
+
+// Mock returns a mock for Repository that calls the functions
+// defined as struct fields in the receiver.
+func (m *RepositoryMocker[K, V]) Mock() RepositoryMock[K, V] {
+	return _makegomock_RepositoryMockFromMocker[K, V]{m}
+}
+
+type _makegomock_RepositoryMockFromMocker[K comparable, V any] struct {
+	m *RepositoryMocker[K, V]
+}
+
+func (m _makegomock_RepositoryMockFromMocker[K, V]) Get(key K) (r0 V, r1 error) {
+	return m.m.Get(key)
+}
+
+func (m _makegomock_RepositoryMockFromMocker[K, V]) Keys() (r0 []K) {
+	return m.m.Keys()
+}
+
+func (m _makegomock_RepositoryMockFromMocker[K, V]) Put(key K, value V) (r0 error) {
+	return m.m.Put(key, value)
+}
+
+// RepositoryMock is a mock with the same underlying type as Repository.
+//
+// It is copied from the original just to avoid introducing a dependency on
+// Repository's package.
+type RepositoryMock[K comparable, V any] interface {
+	Get(key K) (r0 V, r1 error)
+	Keys() (r0 []K)
+	Put(key K, value V) (r0 error)
+}
@@ -0,0 +1,30 @@
+package examples_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/tcard/make.go.mock/examples"
+	"github.com/tcard/make.go.mock/examples/allmocks"
+)
+
+func TestMockAllTypes(t *testing.T) {
+	var repo examples.KeyValuesRepository = (&allmocks.KeyValuesRepositoryMocker{
+		Get: func(key string) (int, error) {
+			return len(key), nil
+		},
+	}).Mock()
+	n, err := repo.Get("foo")
+	assert.NoError(t, err)
+	assert.Equal(t, 3, n)
+
+	f := examples.MyFunc((&allmocks.MyFuncMocker{
+		Func: func(a, b, c int, x bool, multi ...examples.MyStruct) (bool, error) {
+			return x, nil
+		},
+	}).Mock())
+	ok, err := f(1, 2, 3, true)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+}
@@ -0,0 +1,10 @@
+package examples
+
+import "testing"
+
+func TestMockWithoutMethods(t *testing.T) {
+	mock, assertMock := (&AnyMocker{}).Describe().Mock()
+	defer assertMock(t)
+
+	var _ Any = mock
+}
@@ -0,0 +1,409 @@
+package examples_test
+
+import (
+	"bytes"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/tcard/make.go.mock/makegomock"
+)
+
+func TestGenerateWithAPI(t *testing.T) {
+	files, err := makegomock.Generate(makegomock.Options{
+		Package: ".",
+		Types: []makegomock.Target{
+			{Type: "Repository"},
+			{Type: "Repository[string,int]", Rename: "StringIntRepository"},
+			{Type: "Mapper"},
+		},
+		Dst:    "mock_generics_test.go",
+		Assert: true,
+	})
+	if !assert.NoError(t, err) || !assert.Len(t, files, 1) {
+		return
+	}
+	f := files[0]
+
+	assert.Equal(t, "mock_generics_test.go", f.Path)
+	assert.Equal(t, "examples", f.PkgName)
+	assert.Equal(t, "github.com/tcard/make.go.mock/examples", f.ImportPath)
+	assert.Equal(t, []string{"fmt", "runtime", "sync", "github.com/google/go-cmp/cmp"}, f.Imports)
+	assert.Equal(t, []makegomock.Mock{{
+		Type:    "Repository",
+		TypePkg: "github.com/tcard/make.go.mock/examples",
+		Name:    "Repository",
+		Methods: []string{"Get", "Keys", "Put"},
+	}, {
+		Type:    "Repository[string, int]",
+		TypePkg: "github.com/tcard/make.go.mock/examples",
+		Name:    "StringIntRepository",
+		Methods: []string{"Get", "Keys", "Put"},
+	}, {
+		Type:    "Mapper",
+		TypePkg: "github.com/tcard/make.go.mock/examples",
+		Name:    "Mapper",
+		Methods: []string{"Func"},
+	}}, f.Mocks)
+
+	onDisk, err := os.ReadFile(f.Path)
+	assert.NoError(t, err)
+	assert.Equal(t, string(onDisk), string(f.Code))
+	assert.NoError(t, makegomock.CheckFiles(files))
+}
+
+func TestGenerateWithHeader(t *testing.T) {
+	files, err := makegomock.Generate(makegomock.Options{
+		Package: ".",
+		Types:   []makegomock.Target{{Type: "KeyValuesRepository"}},
+		Dst:     makegomock.StdoutPath,
+		Bare:    true,
+		Header:  "Copyright 2019 The Authors.\n\nLicensed as the rest of the module.",
+	})
+	if !assert.NoError(t, err) || !assert.Len(t, files, 1) {
+		return
+	}
+
+	assert.True(t, bytes.HasPrefix(files[0].Code, []byte(`// Copyright 2019 The Authors.
+//
+// Licensed as the rest of the module.
+
+// Code generated by github.com/tcard/make.go.mock. DO NOT EDIT.
+`)), "%s", files[0].Code)
+	assert.Empty(t, files[0].Imports)
+}
+
+func TestGenerateReportsDiagnostics(t *testing.T) {
+	_, err := makegomock.Generate(makegomock.Options{
+		Package: "./testdata/broken",
+		Types:   []makegomock.Target{{Type: "Broken"}},
+		Dst:     makegomock.StdoutPath,
+	})
+	if !assert.Error(t, err) {
+		return
+	}
+
+	path, absErr := filepath.Abs("testdata/broken/broken.go")
+	assert.NoError(t, absErr)
+	assert.Equal(t, []makegomock.Diagnostic{{
+		File:    path,
+		Line:    5,
+		Column:  19,
+		Kind:    "type",
+		Message: "undefined: Missing",
+	}}, makegomock.Diagnostics(err))
+	assert.Equal(t, path+":5:19: undefined: Missing", makegomock.Diagnostics(err)[0].Error())
+}
+
+func TestGenerateReportsDiagnosticsInTestFile(t *testing.T) {
+	_, err := makegomock.Generate(makegomock.Options{
+		GoFile:    "testdata/seam/seam_test.go",
+		GoPackage: "seam",
+		Types:     []makegomock.Target{{Type: "Seam"}},
+		Dst:       makegomock.StdoutPath,
+	})
+	if !assert.Error(t, err) {
+		return
+	}
+
+	path, absErr := filepath.Abs("testdata/seam/seam_test.go")
+	assert.NoError(t, absErr)
+	assert.Equal(t, []makegomock.Diagnostic{{
+		File:    path,
+		Line:    4,
+		Column:  19,
+		Kind:    "type",
+		Message: "undefined: Missing",
+	}}, makegomock.Diagnostics(err))
+}
+
+func TestGenerateUnknownMethod(t *testing.T) {
+	_, err := makegomock.Generate(makegomock.Options{
+		Package: ".",
+		Types:   []makegomock.Target{{Type: "BigClient", Methods: []string{"Get", "Post"}}},
+		Dst:     makegomock.StdoutPath,
+	})
+	assert.EqualError(t, err, "generating code: type BigClient has no method Post to mock")
+}
+
+func TestGenerateFromConfigReportsEntries(t *testing.T) {
+	path := filepath.Join("testdata", "config", "makegomock.json")
+	_, err := makegomock.GenerateFromConfig(path)
+	var msgs []string
+	for _, d := range makegomock.Diagnostics(err) {
+		msgs = append(msgs, d.Message)
+	}
+	assert.Equal(t, []string{
+		path + `: mocks[0] (Nope): type "Nope" not found in package "examples"`,
+		path + `: mocks[1] (func Nope): function "Nope" not found in package "examples"`,
+	}, msgs)
+}
+
+func TestGenerateWithRuntime(t *testing.T) {
+	opts := makegomock.Options{
+		Package: ".",
+		Types:   []makegomock.Target{{Type: "MyInterface"}},
+		Dst:     makegomock.StdoutPath,
+	}
+	files, err := makegomock.Generate(opts)
+	if !assert.NoError(t, err) || !assert.Len(t, files, 1) {
+		return
+	}
+	withoutRuntime := files[0]
+
+	opts.Runtime = true
+	files, err = makegomock.Generate(opts)
+	if !assert.NoError(t, err) || !assert.Len(t, files, 1) {
+		return
+	}
+	withRuntime := files[0]
+
+	assert.Equal(t, []string{"os", "github.com/google/go-cmp/cmp", "github.com/tcard/make.go.mock/mockrt"}, withRuntime.Imports)
+	assert.True(t, len(withRuntime.Code) < len(withoutRuntime.Code), "generated code should be smaller with Runtime")
+}
+
+func TestGenerateFromTestFile(t *testing.T) {
+	opts := makegomock.Options{
+		GoFile:    "testonly_test.go",
+		GoPackage: "examples",
+		Types:     []makegomock.Target{{Type: "Clock"}},
+		DstPkg:    "examples_test",
+		Dst:       "mock_Clock_external_test.go",
+	}
+	files, err := makegomock.Generate(opts)
+	if assert.NoError(t, err) && assert.Len(t, files, 1) {
+		assert.Equal(t, "examples_test", files[0].PkgName)
+	}
+
+	opts.Dst = "mock_Clock.go"
+	_, err = makegomock.Generate(opts)
+	assert.EqualError(t, err, "type Clock is declared in a test file, so its mock must be generated into a _test.go file, not mock_Clock.go")
+}
+
+func TestGenerateIntoParentDir(t *testing.T) {
+	for dst, expected := range map[string]struct{ pkgName, importPath string }{
+		// Named after the directory, as there's no package in it.
+		"../mock_Large_test.go": {"testdata", "github.com/tcard/make.go.mock/examples/testdata"},
+		// Named after the package already in the directory.
+		"../..": {"examples", "github.com/tcard/make.go.mock/examples"},
+	} {
+		files, err := makegomock.Generate(makegomock.Options{
+			GoFile:    "testdata/large/large.go",
+			GoPackage: "large",
+			Types:     []makegomock.Target{{Type: "Large"}},
+			Dst:       dst,
+			Bare:      true,
+		})
+		if assert.NoError(t, err, dst) && assert.Len(t, files, 1, dst) {
+			assert.Equal(t, expected.pkgName, files[0].PkgName, dst)
+			assert.Equal(t, expected.importPath, files[0].ImportPath, dst)
+		}
+	}
+}
+
+func TestGenerateFromPackageOutsideMainModule(t *testing.T) {
+	opts := makegomock.Options{
+		Package: "net/http",
+		Types:   []makegomock.Target{{Type: "RoundTripper"}},
+	}
+	_, err := makegomock.Generate(opts)
+	assert.EqualError(t, err, "package net/http is not in the main module, so mocks can't be generated into its directory; pass a destination")
+
+	opts.Dst = "mock_RoundTripper_test.go"
+	files, err := makegomock.Generate(opts)
+	if assert.NoError(t, err) && assert.Len(t, files, 1) {
+		path, err := filepath.Abs(files[0].Path)
+		assert.NoError(t, err)
+		expectedPath, err := filepath.Abs("mock_RoundTripper_test.go")
+		assert.NoError(t, err)
+		assert.Equal(t, expectedPath, path)
+		assert.Equal(t, "examples", files[0].PkgName)
+		assert.Equal(t, "github.com/tcard/make.go.mock/examples", files[0].ImportPath)
+	}
+}
+
+func TestGenerateFromPackageIntoParentDir(t *testing.T) {
+	files, err := makegomock.Generate(makegomock.Options{
+		Package: "./testdata/large",
+		Types:   []makegomock.Target{{Type: "Large"}},
+		Dst:     "mock_Large_test.go",
+		Bare:    true,
+	})
+	if assert.NoError(t, err) && assert.Len(t, files, 1) {
+		assert.Equal(t, "mock_Large_test.go", files[0].Path)
+		assert.Equal(t, "examples", files[0].PkgName)
+		assert.Equal(t, "github.com/tcard/make.go.mock/examples", files[0].ImportPath)
+	}
+}
+
+func TestGenerateFieldAssertion(t *testing.T) {
+	dir, err := os.MkdirTemp("testdata", "fields")
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	src := filepath.Join(dir, "fields.go")
+	writeSrc := func(onX string) {
+		err := os.WriteFile(src, []byte("package fields\n\ntype Cb struct {\n\tOnX "+onX+"\n\tRetry struct{ OnGiveUp func(error) }\n}\n"), 0o644)
+		assert.NoError(t, err)
+	}
+	build := func() error {
+		return exec.Command("go", "build", "./"+dir).Run()
+	}
+
+	writeSrc("func(string) error")
+	files, err := makegomock.Generate(makegomock.Options{
+		Package: "./" + dir,
+		Types:   []makegomock.Target{{Type: "Cb.OnX"}, {Type: "Cb.Retry.OnGiveUp"}},
+		Dst:     filepath.Join(dir, "mock_fields.go"),
+		Assert:  true,
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+	_, err = makegomock.WriteFiles(files)
+	assert.NoError(t, err)
+	assert.NoError(t, build())
+
+	writeSrc("func(string, int) error")
+	assert.Error(t, build(), "the assertion should fail after the field's type changed")
+}
+
+func TestGenerateFromTestFileWithSrc(t *testing.T) {
+	for src, typ := range map[string]string{
+		"net/http":                               "RoundTripper",
+		"github.com/tcard/make.go.mock/examples": "KeyValuesRepository",
+	} {
+		files, err := makegomock.Generate(makegomock.Options{
+			GoFile:    "testonly_test.go",
+			GoPackage: "examples",
+			Src:       src,
+			Types:     []makegomock.Target{{Type: typ}},
+			Dst:       makegomock.StdoutPath,
+		})
+		if assert.NoError(t, err, src) && assert.Len(t, files, 1, src) {
+			assert.Equal(t, src, files[0].Mocks[0].TypePkg)
+		}
+	}
+}
+
+func TestGenerateFromFileInOtherDir(t *testing.T) {
+	files, err := makegomock.Generate(makegomock.Options{
+		GoFile:    "testdata/large/large.go",
+		GoPackage: "large",
+		Types:     []makegomock.Target{{Type: "Large"}},
+		Dst:       "mock_Large_test.go",
+		Bare:      true,
+	})
+	if assert.NoError(t, err) && assert.Len(t, files, 1) {
+		assert.Equal(t, filepath.Join("testdata", "large", "mock_Large_test.go"), files[0].Path)
+		assert.Equal(t, "large", files[0].PkgName)
+		assert.Equal(t, "github.com/tcard/make.go.mock/examples/testdata/large", files[0].ImportPath)
+	}
+}
+
+func TestGenerateUnmockableAlias(t *testing.T) {
+	for typ, expectedErr := range map[string]string{
+		"Count": `type "Count" is an alias for int, which can't be mocked`,
+		"Set":   `type "Set" is a generic alias, which can't be mocked; mock the type it stands for instead`,
+	} {
+		_, err := makegomock.Generate(makegomock.Options{
+			Package: "./testdata/aliases",
+			Types:   []makegomock.Target{{Type: typ}},
+			Dst:     makegomock.StdoutPath,
+		})
+		assert.EqualError(t, err, expectedErr, typ)
+	}
+}
+
+func TestGenerateShadowingTypeParams(t *testing.T) {
+	for typ, expectedErr := range map[string]string{
+		"ShadowingDescriptor": "generating code: type ShadowingDescriptor has a type parameter named d, which would shadow an identifier in the generated code; rename it",
+		"ShadowingMocker":     "generating code: type ShadowingMocker has a type parameter named m, which would shadow an identifier in the generated code; rename it",
+	} {
+		_, err := makegomock.Generate(makegomock.Options{
+			Package: "./testdata/typeparams",
+			Types:   []makegomock.Target{{Type: typ}},
+			Dst:     makegomock.StdoutPath,
+		})
+		assert.EqualError(t, err, expectedErr, typ)
+	}
+}
+
+func TestGenerateUnnamedFuncTypeParams(t *testing.T) {
+	files, err := makegomock.Generate(makegomock.Options{
+		Package: "./testdata/funcnames",
+		Types:   []makegomock.Target{{Type: "Validator"}},
+		Dst:     makegomock.StdoutPath,
+		Bare:    true,
+	})
+	if assert.NoError(t, err) && assert.Len(t, files, 1) {
+		// Not named after DeletePath's, which has nothing to do with it.
+		assert.Contains(t, string(files[0].Code), "Func func(a0 string) (r0 error)")
+	}
+}
+
+func TestGenerateFunc(t *testing.T) {
+	files, err := makegomock.Generate(makegomock.Options{
+		Package: ".",
+		Types:   []makegomock.Target{{Type: "(*Store).Save", Func: true}},
+		Dst:     makegomock.StdoutPath,
+	})
+	if assert.NoError(t, err) && assert.Len(t, files, 1) {
+		assert.Equal(t, "StoreSave", files[0].Mocks[0].Name)
+		assert.Contains(t, string(files[0].Code), "type StoreSaveMock func(s *Store, key string, value string) error")
+	}
+
+	files, err = makegomock.Generate(makegomock.Options{
+		Package: ".",
+		Types:   []makegomock.Target{{Type: "Repository[string,int].Get", Func: true}},
+		Dst:     makegomock.StdoutPath,
+	})
+	if assert.NoError(t, err) && assert.Len(t, files, 1) {
+		assert.Equal(t, "RepositoryStringIntGet", files[0].Mocks[0].Name)
+		assert.Contains(t, string(files[0].Code), "type RepositoryStringIntGetMock func(_ Repository[string, int], key string) (int, error)")
+	}
+
+	for typ, expectedErr := range map[string]string{
+		"MyInterface": `function "MyInterface" not found in package "examples"`,
+		"Store.Save":  "Store.Save: invalid method expression Store.Save (needs pointer receiver (*Store).Save)",
+		"Store.Nope":  "type Store has no method Nope",
+	} {
+		_, err := makegomock.Generate(makegomock.Options{
+			Package: ".",
+			Types:   []makegomock.Target{{Type: typ, Func: true}},
+			Dst:     makegomock.StdoutPath,
+		})
+		assert.EqualError(t, err, expectedErr, typ)
+	}
+}
+
+func TestGenerateField(t *testing.T) {
+	files, err := makegomock.Generate(makegomock.Options{
+		Package: ".",
+		Types:   []makegomock.Target{{Type: "Options.Retry.OnGiveUp"}},
+		Dst:     makegomock.StdoutPath,
+	})
+	if assert.NoError(t, err) && assert.Len(t, files, 1) {
+		assert.Equal(t, "OptionsRetryOnGiveUp", files[0].Mocks[0].Name)
+		assert.Contains(t, string(files[0].Code), "type OptionsRetryOnGiveUpMock func(err error)")
+	}
+
+	for typ, expectedErr := range map[string]string{
+		"Options.Nope":       "type Options has no field Nope",
+		"Options.Retry.Nope": "type Options.Retry has no field Nope",
+		"Options.Load":       "type Options has no field Load",
+		"MyStruct.SomeField": "field MyStruct.SomeField is of type int, which can't be mocked",
+	} {
+		_, err := makegomock.Generate(makegomock.Options{
+			Package: ".",
+			Types:   []makegomock.Target{{Type: typ}},
+			Dst:     makegomock.StdoutPath,
+		})
+		assert.EqualError(t, err, expectedErr, typ)
+	}
+}
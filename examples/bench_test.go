@@ -0,0 +1,79 @@
+package examples_test
+
+import (
+	"bytes"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/tcard/make.go.mock/makegomock"
+)
+
+var largeOptions = makegomock.Options{
+	Package: "./testdata/large",
+	Types:   []makegomock.Target{{Type: "Large"}},
+	Dst:     makegomock.StdoutPath,
+}
+
+// BenchmarkGenerateLargeInterface reports the size of the mock for an
+// interface with 100 methods, which should grow linearly with the number of
+// methods.
+func BenchmarkGenerateLargeInterface(b *testing.B) {
+	var code []byte
+	for i := 0; i < b.N; i++ {
+		files, err := makegomock.Generate(largeOptions)
+		if err != nil {
+			b.Fatal(err)
+		}
+		code = files[0].Code
+	}
+	reportSize(b, code)
+}
+
+// BenchmarkCompileLargeInterface reports the time it takes to compile the
+// mock for an interface with 100 methods.
+func BenchmarkCompileLargeInterface(b *testing.B) {
+	dir, err := os.MkdirTemp("testdata", "compile")
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	opts := largeOptions
+	opts.Dst = filepath.Join(dir, "mock_large.go")
+	files, err := makegomock.Generate(opts)
+	if err != nil {
+		b.Fatal(err)
+	}
+	f := files[0]
+
+	build := func(i int) {
+		// Make the code differ each time, so that the go command doesn't
+		// reuse the cached build.
+		f.Code = append(files[0].Code[:len(files[0].Code):len(files[0].Code)], "\n// "+strconv.Itoa(i)+"\n"...)
+		err := f.Write()
+		if err != nil {
+			b.Fatal(err)
+		}
+		out, err := exec.Command("go", "build", "./"+dir).CombinedOutput()
+		if err != nil {
+			b.Fatalf("%s\n%s", err, out)
+		}
+	}
+
+	// Build the dependencies first.
+	build(-1)
+	// ResetTimer also discards reported metrics, so size is reported after.
+	b.ResetTimer()
+	reportSize(b, files[0].Code)
+	for i := 0; i < b.N; i++ {
+		build(i)
+	}
+}
+
+func reportSize(b *testing.B, code []byte) {
+	b.ReportMetric(float64(len(code)), "bytes")
+	b.ReportMetric(float64(bytes.Count(code, []byte("\n"))), "lines")
+}
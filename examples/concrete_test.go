@@ -0,0 +1,35 @@
+package examples_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/tcard/make.go.mock/examples"
+	"github.com/tcard/make.go.mock/examples/generated"
+)
+
+func countStored(s generated.Store, keys ...string) int {
+	n := 0
+	for _, k := range keys {
+		if _, ok := s.Load(k); ok {
+			n++
+		}
+	}
+	return n
+}
+
+func TestMockConcreteType(t *testing.T) {
+	store := &examples.Store{}
+	err := store.Save("a", "1")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, countStored(store, "a", "b"))
+
+	mock, assertMock := (&generated.StoreMocker{}).Describe().
+		Load().Takes("a").Returns("1", true).Times(1).
+		Load().Takes("b").Returns("", false).Times(1).
+		Mock()
+	defer assertMock(t)
+
+	assert.Equal(t, 1, countStored(mock, "a", "b"))
+}
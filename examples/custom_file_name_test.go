@@ -3,10 +3,11 @@
 package examples
 
 import (
-	fmt "fmt"
-	cmp "github.com/google/go-cmp/cmp"
-	os "os"
-	runtime "runtime"
+	"fmt"
+	"os"
+	"runtime"
+
+	"github.com/google/go-cmp/cmp"
 )
 
 // MyInterfaceInCustomFileMocker builds mocks for type MyInterface.
@@ -20,11 +21,16 @@ import (
 // The Describe method is a shortcut to define this struct's fields in a
 // declarative manner.
 type MyInterfaceInCustomFileMocker struct {
-	Boring                 func()
-	EmbeddedMethod         func()
+	// Boring takes nothing and returns nothing.
+	Boring         func()
+	EmbeddedMethod func()
+	// ReturnSomethingAtLeast returns an int, which is something at least.
 	ReturnSomethingAtLeast func() (r0 int)
-	ShouldBeFun            func(a0 int, a1 map[string]map[MyStruct]bool, a2 ...chan<- <-chan struct{}) (r0 int, r1 error)
-	StdSomething           func(f *os.File, ints ...int) (named bool)
+	// ShouldBeFun takes some complex types, and a variadic argument. Its
+	// parameters are unnamed, so they get synthetic names in the mock.
+	ShouldBeFun func(a0 int, a1 map[string]map[MyStruct]bool, a2 ...chan<- <-chan struct{}) (r0 int, r1 error)
+	// StdSomething takes a type from the standard library.
+	StdSomething func(f *os.File, ints ...int) (named bool)
 }
 
 // Describe lets you describe how the methods on the resulting mock are expected
@@ -42,12 +48,12 @@ func (m *MyInterfaceInCustomFileMocker) Describe() MyInterfaceInCustomFileMockDe
 // When you're done describing methods, call its Mock method to get a mock that
 // implements the behavior you described.
 type MyInterfaceInCustomFileMockDescriptor struct {
-	m *MyInterfaceInCustomFileMocker
-	descriptors_Boring []*MyInterfaceInCustomFileBoringMockDescriptor
-	descriptors_EmbeddedMethod []*MyInterfaceInCustomFileEmbeddedMethodMockDescriptor
+	m                                  *MyInterfaceInCustomFileMocker
+	descriptors_Boring                 []*MyInterfaceInCustomFileBoringMockDescriptor
+	descriptors_EmbeddedMethod         []*MyInterfaceInCustomFileEmbeddedMethodMockDescriptor
 	descriptors_ReturnSomethingAtLeast []*MyInterfaceInCustomFileReturnSomethingAtLeastMockDescriptor
-	descriptors_ShouldBeFun []*MyInterfaceInCustomFileShouldBeFunMockDescriptor
-	descriptors_StdSomething []*MyInterfaceInCustomFileStdSomethingMockDescriptor
+	descriptors_ShouldBeFun            []*MyInterfaceInCustomFileShouldBeFunMockDescriptor
+	descriptors_StdSomething           []*MyInterfaceInCustomFileStdSomethingMockDescriptor
 }
 
 // Mock returns a mock that the MyInterface interface, following the behavior
@@ -56,18 +62,22 @@ type MyInterfaceInCustomFileMockDescriptor struct {
 // It also returns a function that should be called before the test is done to
 // ensure that the expected number of calls to the mock methods happened. You
 // can pass a *testing.T to it, since it implements the interface it wants.
-func (d MyInterfaceInCustomFileMockDescriptor) Mock() (m MyInterfaceInCustomFileMock, assert func(t interface{ Errorf(s string, args ...interface{})  }) (ok bool)) {
+func (d MyInterfaceInCustomFileMockDescriptor) Mock() (m MyInterfaceInCustomFileMock, assert func(t interface {
+	Errorf(s string, args ...interface{})
+}) (ok bool)) {
 	assert = d.done()
 	return d.m.Mock(), assert
 }
 
-func (d MyInterfaceInCustomFileMockDescriptor) done() func(t interface{ Errorf(s string, args ...interface{})  }) bool {
+func (d MyInterfaceInCustomFileMockDescriptor) done() func(t interface {
+	Errorf(s string, args ...interface{})
+}) bool {
 	var atAssert []func() (method string, errs []string)
 	type specErrs struct {
 		fileLine string
-		errs []string
+		errs     []string
 	}
-	
+
 	if len(d.descriptors_Boring) > 0 {
 		for _, desc := range d.descriptors_Boring {
 			desc := desc
@@ -108,7 +118,7 @@ func (d MyInterfaceInCustomFileMockDescriptor) done() func(t interface{ Errorf(s
 			if len(matching) == 0 {
 				matchingErrs := ""
 				for _, errs := range allErrs {
-					matchingErrs += "\n\tcandidate described at "+errs.fileLine+":\n"
+					matchingErrs += "\n\tcandidate described at " + errs.fileLine + ":\n"
 					for _, err := range errs.errs {
 						matchingErrs += "\n\t\t" + err
 					}
@@ -166,7 +176,7 @@ func (d MyInterfaceInCustomFileMockDescriptor) done() func(t interface{ Errorf(s
 			if len(matching) == 0 {
 				matchingErrs := ""
 				for _, errs := range allErrs {
-					matchingErrs += "\n\tcandidate described at "+errs.fileLine+":\n"
+					matchingErrs += "\n\tcandidate described at " + errs.fileLine + ":\n"
 					for _, err := range errs.errs {
 						matchingErrs += "\n\t\t" + err
 					}
@@ -225,7 +235,7 @@ func (d MyInterfaceInCustomFileMockDescriptor) done() func(t interface{ Errorf(s
 			if len(matching) == 0 {
 				matchingErrs := ""
 				for _, errs := range allErrs {
-					matchingErrs += "\n\tcandidate described at "+errs.fileLine+":\n"
+					matchingErrs += "\n\tcandidate described at " + errs.fileLine + ":\n"
 					for _, err := range errs.errs {
 						matchingErrs += "\n\t\t" + err
 					}
@@ -284,7 +294,7 @@ func (d MyInterfaceInCustomFileMockDescriptor) done() func(t interface{ Errorf(s
 			if len(matching) == 0 {
 				matchingErrs := ""
 				for _, errs := range allErrs {
-					matchingErrs += "\n\tcandidate described at "+errs.fileLine+":\n"
+					matchingErrs += "\n\tcandidate described at " + errs.fileLine + ":\n"
 					for _, err := range errs.errs {
 						matchingErrs += "\n\t\t" + err
 					}
@@ -343,7 +353,7 @@ func (d MyInterfaceInCustomFileMockDescriptor) done() func(t interface{ Errorf(s
 			if len(matching) == 0 {
 				matchingErrs := ""
 				for _, errs := range allErrs {
-					matchingErrs += "\n\tcandidate described at "+errs.fileLine+":\n"
+					matchingErrs += "\n\tcandidate described at " + errs.fileLine + ":\n"
 					for _, err := range errs.errs {
 						matchingErrs += "\n\t\t" + err
 					}
@@ -361,7 +371,9 @@ func (d MyInterfaceInCustomFileMockDescriptor) done() func(t interface{ Errorf(s
 			panic("unexpected call to mock for MyInterfaceInCustomFile.StdSomething")
 		}
 	}
-	return func(t interface{ Errorf(s string, args ...interface{})  }) bool {
+	return func(t interface {
+		Errorf(s string, args ...interface{})
+	}) bool {
 		ok := true
 		for _, assert := range atAssert {
 			method, errs := assert()
@@ -373,38 +385,23 @@ func (d MyInterfaceInCustomFileMockDescriptor) done() func(t interface{ Errorf(s
 		return ok
 	}
 }
-	
-// Boring starts describing a way method MyInterfaceInCustomFile.Boring is expected to be called
-// and what it should return.
-//
-// You can call it several times to describe different behaviors, each matching different parameters.
-func (d MyInterfaceInCustomFileMockDescriptor) Boring() *MyInterfaceInCustomFileBoringMockDescriptor {
-	return d.newMyInterfaceInCustomFileBoringMockDescriptor()
-}
 
-func (d MyInterfaceInCustomFileMockDescriptor) newMyInterfaceInCustomFileBoringMockDescriptor() *MyInterfaceInCustomFileBoringMockDescriptor {
-	_, file, line, _ := runtime.Caller(2)
-	return &MyInterfaceInCustomFileBoringMockDescriptor{
-		mockDesc: d,
-		times: func(int) error { return nil },
-		argValidator: func() []string { return nil },
-		fileLine: fmt.Sprintf("%s:%d", file, line),
-	}
+// MyInterfaceInCustomFileMockDescribedCall is the last step in the description of a way that a
+// method of MyInterfaceInCustomFile is to behave when called, with all expected parameters
+// and the resulting values specified.
+//
+// It has methods to describe the times the method is expected to be called.
+// Otherwise, through the embedded MyInterfaceInCustomFileMockDescriptor, you can start
+// another method call description, or you can call Mock to end the description
+// and get the resulting mock.
+type MyInterfaceInCustomFileMockDescribedCall struct {
+	MyInterfaceInCustomFileMockDescriptor
+	times *func(int) error
 }
 
-// MyInterfaceInCustomFileBoringMockDescriptor is returned by MyInterfaceInCustomFileMockDescriptor.Boring and
-// holds methods to describe the mock for method MyInterfaceInCustomFile.Boring.
-type MyInterfaceInCustomFileBoringMockDescriptor struct {
-	mockDesc MyInterfaceInCustomFileMockDescriptor
-	times func(int) error
-	argValidator func() []string
-	call func()
-	fileLine string
-}
-	
 // Times lets you specify a exact number of times this method is expected to be
 // called.
-func (d *MyInterfaceInCustomFileBoringMockDescriptor) Times(times int) MyInterfaceInCustomFileMockDescriptor {
+func (d MyInterfaceInCustomFileMockDescribedCall) Times(times int) MyInterfaceInCustomFileMockDescriptor {
 	return d.TimesMatching(func(got int) error {
 		if got != times {
 			return fmt.Errorf("expected exactly %d calls, got %d", times, got)
@@ -415,7 +412,7 @@ func (d *MyInterfaceInCustomFileBoringMockDescriptor) Times(times int) MyInterfa
 
 // AtLeastTimes lets you specify a minimum number of times this method is expected to be
 // called.
-func (d *MyInterfaceInCustomFileBoringMockDescriptor) AtLeastTimes(times int) MyInterfaceInCustomFileMockDescriptor {
+func (d MyInterfaceInCustomFileMockDescribedCall) AtLeastTimes(times int) MyInterfaceInCustomFileMockDescriptor {
 	return d.TimesMatching(func(got int) error {
 		if got < times {
 			return fmt.Errorf("expected at least %d calls, got %d", times, got)
@@ -426,188 +423,91 @@ func (d *MyInterfaceInCustomFileBoringMockDescriptor) AtLeastTimes(times int) My
 
 // TimesMatching lets you pass a function to accept or reject the number of times
 // this method has been called.
-func (d *MyInterfaceInCustomFileBoringMockDescriptor) TimesMatching(f func(times int) error) MyInterfaceInCustomFileMockDescriptor {
-	d.times = f
-	d.done()
-	return d.mockDesc
+func (d MyInterfaceInCustomFileMockDescribedCall) TimesMatching(f func(times int) error) MyInterfaceInCustomFileMockDescriptor {
+	*d.times = f
+	return d.MyInterfaceInCustomFileMockDescriptor
 }
 
-// Mock finishes the description and produces a mock.
-//
-// See MyInterfaceInCustomFileMockDescriptor.Mock for details.
-func (d *MyInterfaceInCustomFileBoringMockDescriptor) Mock() (m MyInterfaceInCustomFileMock, assert func(t interface{ Errorf(string, ...interface{})  }) (ok bool)) {
-	d.done()
-	return d.mockDesc.Mock()
-}
-	
-// Boring finishes the current description for method MyInterfaceInCustomFile.Boring and
-// starts describing for method Boring.
+// Boring starts describing a way method MyInterfaceInCustomFile.Boring is expected to be called
+// and what it should return.
 //
-// See MyInterfaceInCustomFileMockDescriptor.Boring for details.
-func (d *MyInterfaceInCustomFileBoringMockDescriptor) Boring() *MyInterfaceInCustomFileBoringMockDescriptor {
-	d.done()
-	return d.mockDesc.newMyInterfaceInCustomFileBoringMockDescriptor()
-}
-	
-// EmbeddedMethod finishes the current description for method MyInterfaceInCustomFile.Boring and
-// starts describing for method EmbeddedMethod.
+// You can call it several times to describe different behaviors, each matching different parameters.
 //
-// See MyInterfaceInCustomFileMockDescriptor.EmbeddedMethod for details.
-func (d *MyInterfaceInCustomFileBoringMockDescriptor) EmbeddedMethod() *MyInterfaceInCustomFileEmbeddedMethodMockDescriptor {
-	d.done()
-	return d.mockDesc.newMyInterfaceInCustomFileEmbeddedMethodMockDescriptor()
-}
-	
-// ReturnSomethingAtLeast finishes the current description for method MyInterfaceInCustomFile.Boring and
-// starts describing for method ReturnSomethingAtLeast.
+// MyInterface.Boring is documented as follows.
 //
-// See MyInterfaceInCustomFileMockDescriptor.ReturnSomethingAtLeast for details.
-func (d *MyInterfaceInCustomFileBoringMockDescriptor) ReturnSomethingAtLeast() *MyInterfaceInCustomFileReturnSomethingAtLeastMockDescriptor {
-	d.done()
-	return d.mockDesc.newMyInterfaceInCustomFileReturnSomethingAtLeastMockDescriptor()
+// Boring takes nothing and returns nothing.
+func (d MyInterfaceInCustomFileMockDescriptor) Boring() MyInterfaceInCustomFileMockDescribedCall {
+	return d.newMyInterfaceInCustomFileBoringMockDescriptor().done()
 }
-	
-// ShouldBeFun finishes the current description for method MyInterfaceInCustomFile.Boring and
-// starts describing for method ShouldBeFun.
-//
-// See MyInterfaceInCustomFileMockDescriptor.ShouldBeFun for details.
-func (d *MyInterfaceInCustomFileBoringMockDescriptor) ShouldBeFun() *MyInterfaceInCustomFileShouldBeFunMockDescriptor {
-	d.done()
-	return d.mockDesc.newMyInterfaceInCustomFileShouldBeFunMockDescriptor()
+
+func (d MyInterfaceInCustomFileMockDescriptor) newMyInterfaceInCustomFileBoringMockDescriptor() *MyInterfaceInCustomFileBoringMockDescriptor {
+	_, file, line, _ := runtime.Caller(2)
+	return &MyInterfaceInCustomFileBoringMockDescriptor{
+		mockDesc:     d,
+		times:        func(int) error { return nil },
+		argValidator: func() []string { return nil },
+		fileLine:     fmt.Sprintf("%s:%d", file, line),
+	}
 }
-	
-// StdSomething finishes the current description for method MyInterfaceInCustomFile.Boring and
-// starts describing for method StdSomething.
-//
-// See MyInterfaceInCustomFileMockDescriptor.StdSomething for details.
-func (d *MyInterfaceInCustomFileBoringMockDescriptor) StdSomething() *MyInterfaceInCustomFileStdSomethingMockDescriptor {
-	d.done()
-	return d.mockDesc.newMyInterfaceInCustomFileStdSomethingMockDescriptor()
+
+// MyInterfaceInCustomFileBoringMockDescriptor is returned by MyInterfaceInCustomFileMockDescriptor.Boring and
+// holds methods to describe the mock for method MyInterfaceInCustomFile.Boring.
+type MyInterfaceInCustomFileBoringMockDescriptor struct {
+	mockDesc     MyInterfaceInCustomFileMockDescriptor
+	times        func(int) error
+	argValidator func() []string
+	call         func()
+	fileLine     string
 }
-	
-func (d *MyInterfaceInCustomFileBoringMockDescriptor) done() {
+
+// done ends the description, adding it to the mock descriptor.
+func (d *MyInterfaceInCustomFileBoringMockDescriptor) done() MyInterfaceInCustomFileMockDescribedCall {
 	d.mockDesc.descriptors_Boring = append(d.mockDesc.descriptors_Boring, d)
+	return MyInterfaceInCustomFileMockDescribedCall{d.mockDesc, &d.times}
 }
-	
+
 // EmbeddedMethod starts describing a way method MyInterfaceInCustomFile.EmbeddedMethod is expected to be called
 // and what it should return.
 //
 // You can call it several times to describe different behaviors, each matching different parameters.
-func (d MyInterfaceInCustomFileMockDescriptor) EmbeddedMethod() *MyInterfaceInCustomFileEmbeddedMethodMockDescriptor {
-	return d.newMyInterfaceInCustomFileEmbeddedMethodMockDescriptor()
+func (d MyInterfaceInCustomFileMockDescriptor) EmbeddedMethod() MyInterfaceInCustomFileMockDescribedCall {
+	return d.newMyInterfaceInCustomFileEmbeddedMethodMockDescriptor().done()
 }
 
 func (d MyInterfaceInCustomFileMockDescriptor) newMyInterfaceInCustomFileEmbeddedMethodMockDescriptor() *MyInterfaceInCustomFileEmbeddedMethodMockDescriptor {
 	_, file, line, _ := runtime.Caller(2)
 	return &MyInterfaceInCustomFileEmbeddedMethodMockDescriptor{
-		mockDesc: d,
-		times: func(int) error { return nil },
+		mockDesc:     d,
+		times:        func(int) error { return nil },
 		argValidator: func() []string { return nil },
-		fileLine: fmt.Sprintf("%s:%d", file, line),
+		fileLine:     fmt.Sprintf("%s:%d", file, line),
 	}
 }
 
 // MyInterfaceInCustomFileEmbeddedMethodMockDescriptor is returned by MyInterfaceInCustomFileMockDescriptor.EmbeddedMethod and
 // holds methods to describe the mock for method MyInterfaceInCustomFile.EmbeddedMethod.
 type MyInterfaceInCustomFileEmbeddedMethodMockDescriptor struct {
-	mockDesc MyInterfaceInCustomFileMockDescriptor
-	times func(int) error
+	mockDesc     MyInterfaceInCustomFileMockDescriptor
+	times        func(int) error
 	argValidator func() []string
-	call func()
-	fileLine string
-}
-	
-// Times lets you specify a exact number of times this method is expected to be
-// called.
-func (d *MyInterfaceInCustomFileEmbeddedMethodMockDescriptor) Times(times int) MyInterfaceInCustomFileMockDescriptor {
-	return d.TimesMatching(func(got int) error {
-		if got != times {
-			return fmt.Errorf("expected exactly %d calls, got %d", times, got)
-		}
-		return nil
-	})
-}
-
-// AtLeastTimes lets you specify a minimum number of times this method is expected to be
-// called.
-func (d *MyInterfaceInCustomFileEmbeddedMethodMockDescriptor) AtLeastTimes(times int) MyInterfaceInCustomFileMockDescriptor {
-	return d.TimesMatching(func(got int) error {
-		if got < times {
-			return fmt.Errorf("expected at least %d calls, got %d", times, got)
-		}
-		return nil
-	})
+	call         func()
+	fileLine     string
 }
 
-// TimesMatching lets you pass a function to accept or reject the number of times
-// this method has been called.
-func (d *MyInterfaceInCustomFileEmbeddedMethodMockDescriptor) TimesMatching(f func(times int) error) MyInterfaceInCustomFileMockDescriptor {
-	d.times = f
-	d.done()
-	return d.mockDesc
-}
-
-// Mock finishes the description and produces a mock.
-//
-// See MyInterfaceInCustomFileMockDescriptor.Mock for details.
-func (d *MyInterfaceInCustomFileEmbeddedMethodMockDescriptor) Mock() (m MyInterfaceInCustomFileMock, assert func(t interface{ Errorf(string, ...interface{})  }) (ok bool)) {
-	d.done()
-	return d.mockDesc.Mock()
-}
-	
-// Boring finishes the current description for method MyInterfaceInCustomFile.EmbeddedMethod and
-// starts describing for method Boring.
-//
-// See MyInterfaceInCustomFileMockDescriptor.Boring for details.
-func (d *MyInterfaceInCustomFileEmbeddedMethodMockDescriptor) Boring() *MyInterfaceInCustomFileBoringMockDescriptor {
-	d.done()
-	return d.mockDesc.newMyInterfaceInCustomFileBoringMockDescriptor()
-}
-	
-// EmbeddedMethod finishes the current description for method MyInterfaceInCustomFile.EmbeddedMethod and
-// starts describing for method EmbeddedMethod.
-//
-// See MyInterfaceInCustomFileMockDescriptor.EmbeddedMethod for details.
-func (d *MyInterfaceInCustomFileEmbeddedMethodMockDescriptor) EmbeddedMethod() *MyInterfaceInCustomFileEmbeddedMethodMockDescriptor {
-	d.done()
-	return d.mockDesc.newMyInterfaceInCustomFileEmbeddedMethodMockDescriptor()
-}
-	
-// ReturnSomethingAtLeast finishes the current description for method MyInterfaceInCustomFile.EmbeddedMethod and
-// starts describing for method ReturnSomethingAtLeast.
-//
-// See MyInterfaceInCustomFileMockDescriptor.ReturnSomethingAtLeast for details.
-func (d *MyInterfaceInCustomFileEmbeddedMethodMockDescriptor) ReturnSomethingAtLeast() *MyInterfaceInCustomFileReturnSomethingAtLeastMockDescriptor {
-	d.done()
-	return d.mockDesc.newMyInterfaceInCustomFileReturnSomethingAtLeastMockDescriptor()
-}
-	
-// ShouldBeFun finishes the current description for method MyInterfaceInCustomFile.EmbeddedMethod and
-// starts describing for method ShouldBeFun.
-//
-// See MyInterfaceInCustomFileMockDescriptor.ShouldBeFun for details.
-func (d *MyInterfaceInCustomFileEmbeddedMethodMockDescriptor) ShouldBeFun() *MyInterfaceInCustomFileShouldBeFunMockDescriptor {
-	d.done()
-	return d.mockDesc.newMyInterfaceInCustomFileShouldBeFunMockDescriptor()
-}
-	
-// StdSomething finishes the current description for method MyInterfaceInCustomFile.EmbeddedMethod and
-// starts describing for method StdSomething.
-//
-// See MyInterfaceInCustomFileMockDescriptor.StdSomething for details.
-func (d *MyInterfaceInCustomFileEmbeddedMethodMockDescriptor) StdSomething() *MyInterfaceInCustomFileStdSomethingMockDescriptor {
-	d.done()
-	return d.mockDesc.newMyInterfaceInCustomFileStdSomethingMockDescriptor()
-}
-	
-func (d *MyInterfaceInCustomFileEmbeddedMethodMockDescriptor) done() {
+// done ends the description, adding it to the mock descriptor.
+func (d *MyInterfaceInCustomFileEmbeddedMethodMockDescriptor) done() MyInterfaceInCustomFileMockDescribedCall {
 	d.mockDesc.descriptors_EmbeddedMethod = append(d.mockDesc.descriptors_EmbeddedMethod, d)
+	return MyInterfaceInCustomFileMockDescribedCall{d.mockDesc, &d.times}
 }
-	
+
 // ReturnSomethingAtLeast starts describing a way method MyInterfaceInCustomFile.ReturnSomethingAtLeast is expected to be called
 // and what it should return.
 //
 // You can call it several times to describe different behaviors, each matching different parameters.
+//
+// MyInterface.ReturnSomethingAtLeast is documented as follows.
+//
+// ReturnSomethingAtLeast returns an int, which is something at least.
 func (d MyInterfaceInCustomFileMockDescriptor) ReturnSomethingAtLeast() *MyInterfaceInCustomFileReturnSomethingAtLeastMockDescriptor {
 	return d.newMyInterfaceInCustomFileReturnSomethingAtLeastMockDescriptor()
 }
@@ -615,143 +515,55 @@ func (d MyInterfaceInCustomFileMockDescriptor) ReturnSomethingAtLeast() *MyInter
 func (d MyInterfaceInCustomFileMockDescriptor) newMyInterfaceInCustomFileReturnSomethingAtLeastMockDescriptor() *MyInterfaceInCustomFileReturnSomethingAtLeastMockDescriptor {
 	_, file, line, _ := runtime.Caller(2)
 	return &MyInterfaceInCustomFileReturnSomethingAtLeastMockDescriptor{
-		mockDesc: d,
-		times: func(int) error { return nil },
+		mockDesc:     d,
+		times:        func(int) error { return nil },
 		argValidator: func() []string { return nil },
-		fileLine: fmt.Sprintf("%s:%d", file, line),
+		fileLine:     fmt.Sprintf("%s:%d", file, line),
 	}
 }
 
 // MyInterfaceInCustomFileReturnSomethingAtLeastMockDescriptor is returned by MyInterfaceInCustomFileMockDescriptor.ReturnSomethingAtLeast and
 // holds methods to describe the mock for method MyInterfaceInCustomFile.ReturnSomethingAtLeast.
 type MyInterfaceInCustomFileReturnSomethingAtLeastMockDescriptor struct {
-	mockDesc MyInterfaceInCustomFileMockDescriptor
-	times func(int) error
+	mockDesc     MyInterfaceInCustomFileMockDescriptor
+	times        func(int) error
 	argValidator func() []string
-	call func() (r0 int)
-	fileLine string
+	call         func() (r0 int)
+	fileLine     string
 }
-	
+
 // Returns lets you specify the values that the mocked method MyInterfaceInCustomFile.ReturnSomethingAtLeast,
 // if called with values matching the expectations, will return.
-func (d *MyInterfaceInCustomFileReturnSomethingAtLeastMockDescriptor) Returns(r0 int) MyInterfaceInCustomFileReturnSomethingAtLeastMockDescriptorWithReturn {
+func (d *MyInterfaceInCustomFileReturnSomethingAtLeastMockDescriptor) Returns(r0 int) MyInterfaceInCustomFileMockDescribedCall {
 	return d.ReturnsFrom(func() int {
 		return r0
 	})
 }
 
-// Returns lets you specify the values that the mocked method MyInterfaceInCustomFile.ReturnSomethingAtLeast,
+// ReturnsFrom lets you specify the values that the mocked method MyInterfaceInCustomFile.ReturnSomethingAtLeast,
 // if called with values matching the expectations, will return.
-// 
-// It passes such passed values to a function that then returns the return values. 
-func (d *MyInterfaceInCustomFileReturnSomethingAtLeastMockDescriptor) ReturnsFrom(f func() (r0 int)) MyInterfaceInCustomFileReturnSomethingAtLeastMockDescriptorWithReturn {
+//
+// It passes such passed values to a function that then returns the return values.
+func (d *MyInterfaceInCustomFileReturnSomethingAtLeastMockDescriptor) ReturnsFrom(f func() (r0 int)) MyInterfaceInCustomFileMockDescribedCall {
 	d.call = f
-	return MyInterfaceInCustomFileReturnSomethingAtLeastMockDescriptorWithReturn{d}
-}
-
-// MyInterfaceInCustomFileReturnSomethingAtLeastMockDescriptorWithReturn is a step forward in the description of a way that
-// method MyInterfaceInCustomFile.ReturnSomethingAtLeast is to behave when called, with all expected parameters
-// and the resulting values specified.
-// arguments specified.
-// 
-// It has methods to describe the times the method is expected to be called,
-// or you can start another method call description, or you can call Mock to
-// end the description and get the resulting mock.
-type MyInterfaceInCustomFileReturnSomethingAtLeastMockDescriptorWithReturn struct {
-	methodDesc *MyInterfaceInCustomFileReturnSomethingAtLeastMockDescriptor
-}
-	
-// Times lets you specify a exact number of times this method is expected to be
-// called.
-func (d MyInterfaceInCustomFileReturnSomethingAtLeastMockDescriptorWithReturn) Times(times int) MyInterfaceInCustomFileMockDescriptor {
-	return d.TimesMatching(func(got int) error {
-		if got != times {
-			return fmt.Errorf("expected exactly %d calls, got %d", times, got)
-		}
-		return nil
-	})
-}
-
-// AtLeastTimes lets you specify a minimum number of times this method is expected to be
-// called.
-func (d MyInterfaceInCustomFileReturnSomethingAtLeastMockDescriptorWithReturn) AtLeastTimes(times int) MyInterfaceInCustomFileMockDescriptor {
-	return d.TimesMatching(func(got int) error {
-		if got < times {
-			return fmt.Errorf("expected at least %d calls, got %d", times, got)
-		}
-		return nil
-	})
-}
-
-// TimesMatching lets you pass a function to accept or reject the number of times
-// this method has been called.
-func (d MyInterfaceInCustomFileReturnSomethingAtLeastMockDescriptorWithReturn) TimesMatching(f func(times int) error) MyInterfaceInCustomFileMockDescriptor {
-	d.methodDesc.times = f
-	d.methodDesc.done()
-	return d.methodDesc.mockDesc
+	return d.done()
 }
 
-// Mock finishes the description and produces a mock.
-//
-// See MyInterfaceInCustomFileMockDescriptor.Mock for details.
-func (d MyInterfaceInCustomFileReturnSomethingAtLeastMockDescriptorWithReturn) Mock() (m MyInterfaceInCustomFileMock, assert func(t interface{ Errorf(string, ...interface{})  }) (ok bool)) {
-	d.methodDesc.done()
-	return d.methodDesc.mockDesc.Mock()
-}
-	
-// Boring finishes the current description for method MyInterfaceInCustomFile.ReturnSomethingAtLeast and
-// starts describing for method Boring.
-//
-// See MyInterfaceInCustomFileMockDescriptor.Boring for details.
-func (d MyInterfaceInCustomFileReturnSomethingAtLeastMockDescriptorWithReturn) Boring() *MyInterfaceInCustomFileBoringMockDescriptor {
-	d.methodDesc.done()
-	return d.methodDesc.mockDesc.newMyInterfaceInCustomFileBoringMockDescriptor()
-}
-	
-// EmbeddedMethod finishes the current description for method MyInterfaceInCustomFile.ReturnSomethingAtLeast and
-// starts describing for method EmbeddedMethod.
-//
-// See MyInterfaceInCustomFileMockDescriptor.EmbeddedMethod for details.
-func (d MyInterfaceInCustomFileReturnSomethingAtLeastMockDescriptorWithReturn) EmbeddedMethod() *MyInterfaceInCustomFileEmbeddedMethodMockDescriptor {
-	d.methodDesc.done()
-	return d.methodDesc.mockDesc.newMyInterfaceInCustomFileEmbeddedMethodMockDescriptor()
-}
-	
-// ReturnSomethingAtLeast finishes the current description for method MyInterfaceInCustomFile.ReturnSomethingAtLeast and
-// starts describing for method ReturnSomethingAtLeast.
-//
-// See MyInterfaceInCustomFileMockDescriptor.ReturnSomethingAtLeast for details.
-func (d MyInterfaceInCustomFileReturnSomethingAtLeastMockDescriptorWithReturn) ReturnSomethingAtLeast() *MyInterfaceInCustomFileReturnSomethingAtLeastMockDescriptor {
-	d.methodDesc.done()
-	return d.methodDesc.mockDesc.newMyInterfaceInCustomFileReturnSomethingAtLeastMockDescriptor()
-}
-	
-// ShouldBeFun finishes the current description for method MyInterfaceInCustomFile.ReturnSomethingAtLeast and
-// starts describing for method ShouldBeFun.
-//
-// See MyInterfaceInCustomFileMockDescriptor.ShouldBeFun for details.
-func (d MyInterfaceInCustomFileReturnSomethingAtLeastMockDescriptorWithReturn) ShouldBeFun() *MyInterfaceInCustomFileShouldBeFunMockDescriptor {
-	d.methodDesc.done()
-	return d.methodDesc.mockDesc.newMyInterfaceInCustomFileShouldBeFunMockDescriptor()
-}
-	
-// StdSomething finishes the current description for method MyInterfaceInCustomFile.ReturnSomethingAtLeast and
-// starts describing for method StdSomething.
-//
-// See MyInterfaceInCustomFileMockDescriptor.StdSomething for details.
-func (d MyInterfaceInCustomFileReturnSomethingAtLeastMockDescriptorWithReturn) StdSomething() *MyInterfaceInCustomFileStdSomethingMockDescriptor {
-	d.methodDesc.done()
-	return d.methodDesc.mockDesc.newMyInterfaceInCustomFileStdSomethingMockDescriptor()
-}
-	
-func (d *MyInterfaceInCustomFileReturnSomethingAtLeastMockDescriptor) done() {
+// done ends the description, adding it to the mock descriptor.
+func (d *MyInterfaceInCustomFileReturnSomethingAtLeastMockDescriptor) done() MyInterfaceInCustomFileMockDescribedCall {
 	d.mockDesc.descriptors_ReturnSomethingAtLeast = append(d.mockDesc.descriptors_ReturnSomethingAtLeast, d)
+	return MyInterfaceInCustomFileMockDescribedCall{d.mockDesc, &d.times}
 }
-	
+
 // ShouldBeFun starts describing a way method MyInterfaceInCustomFile.ShouldBeFun is expected to be called
 // and what it should return.
 //
 // You can call it several times to describe different behaviors, each matching different parameters.
+//
+// MyInterface.ShouldBeFun is documented as follows.
+//
+// ShouldBeFun takes some complex types, and a variadic argument. Its
+// parameters are unnamed, so they get synthetic names in the mock.
 func (d MyInterfaceInCustomFileMockDescriptor) ShouldBeFun() *MyInterfaceInCustomFileShouldBeFunMockDescriptor {
 	return d.newMyInterfaceInCustomFileShouldBeFunMockDescriptor()
 }
@@ -760,8 +572,10 @@ func (d MyInterfaceInCustomFileMockDescriptor) newMyInterfaceInCustomFileShouldB
 	_, file, line, _ := runtime.Caller(2)
 	return &MyInterfaceInCustomFileShouldBeFunMockDescriptor{
 		mockDesc: d,
-		times: func(int) error { return nil },
-		argValidator: func(got_a0 int, got_a1 map[string]map[MyStruct]bool, got_a2 []chan<- <-chan struct{}) []string { return nil },
+		times:    func(int) error { return nil },
+		argValidator: func(got_a0 int, got_a1 map[string]map[MyStruct]bool, got_a2 []chan<- <-chan struct{}) []string {
+			return nil
+		},
 		fileLine: fmt.Sprintf("%s:%d", file, line),
 	}
 }
@@ -769,16 +583,16 @@ func (d MyInterfaceInCustomFileMockDescriptor) newMyInterfaceInCustomFileShouldB
 // MyInterfaceInCustomFileShouldBeFunMockDescriptor is returned by MyInterfaceInCustomFileMockDescriptor.ShouldBeFun and
 // holds methods to describe the mock for method MyInterfaceInCustomFile.ShouldBeFun.
 type MyInterfaceInCustomFileShouldBeFunMockDescriptor struct {
-	mockDesc MyInterfaceInCustomFileMockDescriptor
-	times func(int) error
+	mockDesc     MyInterfaceInCustomFileMockDescriptor
+	times        func(int) error
 	argValidator func(got_a0 int, got_a1 map[string]map[MyStruct]bool, got_a2 []chan<- <-chan struct{}) []string
-	call func(a0 int, a1 map[string]map[MyStruct]bool, a2 []chan<- <-chan struct{}) (r0 int, r1 error)
-	fileLine string
+	call         func(a0 int, a1 map[string]map[MyStruct]bool, a2 []chan<- <-chan struct{}) (r0 int, r1 error)
+	fileLine     string
 }
-	
+
 // Takes lets you specify a value with which the actual value passed to
 // the mocked method MyInterfaceInCustomFile.ShouldBeFun as parameter #1
-// will be compared. 
+// will be compared.
 //
 // Package "github.com/google/go-cmp/cmp" is used to do the comparison. You can
 // pass extra options for it.
@@ -791,7 +605,7 @@ func (d *MyInterfaceInCustomFileShouldBeFunMockDescriptor) Takes(a0 int, opts ..
 	d.argValidator = func(got_a0 int, got_a1 map[string]map[MyStruct]bool, got_a2 []chan<- <-chan struct{}) []string {
 		errMsgs := prev(got_a0, got_a1, got_a2)
 		if diff := cmp.Diff(a0, got_a0, opts...); diff != "" {
-			errMsgs = append(errMsgs, "parameter #1 mismatch:\n" + diff)
+			errMsgs = append(errMsgs, "parameter #1 mismatch:\n"+diff)
 		}
 		return errMsgs
 	}
@@ -811,7 +625,7 @@ func (d *MyInterfaceInCustomFileShouldBeFunMockDescriptor) TakesMatching(match f
 	d.argValidator = func(got_a0 int, got_a1 map[string]map[MyStruct]bool, got_a2 []chan<- <-chan struct{}) []string {
 		errMsgs := prev(got_a0, got_a1, got_a2)
 		if err := match(got_a0); err != nil {
-			errMsgs = append(errMsgs, "parameter \"a0\" custom matcher error: " + err.Error())
+			errMsgs = append(errMsgs, "parameter \"a0\" custom matcher error: "+err.Error())
 		}
 		return errMsgs
 	}
@@ -822,16 +636,15 @@ func (d *MyInterfaceInCustomFileShouldBeFunMockDescriptor) TakesMatching(match f
 // method MyInterfaceInCustomFile.ShouldBeFun is expected to be called, with 1
 // arguments specified.
 //
-// It has methods to describe the next argument, if there's
-// any left, or the return values, if there are any, or the times it's expected
-// to be called otherwise.
+// It has methods to describe the next argument, if there's any left, or the
+// return values otherwise.
 type MyInterfaceInCustomFileShouldBeFunMockDescriptorWith1Arg struct {
 	methodDesc *MyInterfaceInCustomFileShouldBeFunMockDescriptor
 }
-	
+
 // And lets you specify a value with which the actual value passed to
 // the mocked method MyInterfaceInCustomFile.ShouldBeFun as parameter #2
-// will be compared. 
+// will be compared.
 //
 // Package "github.com/google/go-cmp/cmp" is used to do the comparison. You can
 // pass extra options for it.
@@ -844,7 +657,7 @@ func (d MyInterfaceInCustomFileShouldBeFunMockDescriptorWith1Arg) And(a1 map[str
 	d.methodDesc.argValidator = func(got_a0 int, got_a1 map[string]map[MyStruct]bool, got_a2 []chan<- <-chan struct{}) []string {
 		errMsgs := prev(got_a0, got_a1, got_a2)
 		if diff := cmp.Diff(a1, got_a1, opts...); diff != "" {
-			errMsgs = append(errMsgs, "parameter #2 mismatch:\n" + diff)
+			errMsgs = append(errMsgs, "parameter #2 mismatch:\n"+diff)
 		}
 		return errMsgs
 	}
@@ -864,7 +677,7 @@ func (d MyInterfaceInCustomFileShouldBeFunMockDescriptorWith1Arg) AndMatching(ma
 	d.methodDesc.argValidator = func(got_a0 int, got_a1 map[string]map[MyStruct]bool, got_a2 []chan<- <-chan struct{}) []string {
 		errMsgs := prev(got_a0, got_a1, got_a2)
 		if err := match(got_a1); err != nil {
-			errMsgs = append(errMsgs, "parameter \"a1\" custom matcher error: " + err.Error())
+			errMsgs = append(errMsgs, "parameter \"a1\" custom matcher error: "+err.Error())
 		}
 		return errMsgs
 	}
@@ -875,16 +688,15 @@ func (d MyInterfaceInCustomFileShouldBeFunMockDescriptorWith1Arg) AndMatching(ma
 // method MyInterfaceInCustomFile.ShouldBeFun is expected to be called, with 2
 // arguments specified.
 //
-// It has methods to describe the next argument, if there's
-// any left, or the return values, if there are any, or the times it's expected
-// to be called otherwise.
+// It has methods to describe the next argument, if there's any left, or the
+// return values otherwise.
 type MyInterfaceInCustomFileShouldBeFunMockDescriptorWith2Args struct {
 	methodDesc *MyInterfaceInCustomFileShouldBeFunMockDescriptor
 }
-	
+
 // And lets you specify a value with which the actual value passed to
 // the mocked method MyInterfaceInCustomFile.ShouldBeFun as parameter #3
-// will be compared. 
+// will be compared.
 //
 // Package "github.com/google/go-cmp/cmp" is used to do the comparison. You can
 // pass extra options for it.
@@ -897,7 +709,7 @@ func (d MyInterfaceInCustomFileShouldBeFunMockDescriptorWith2Args) And(a2 []chan
 	d.methodDesc.argValidator = func(got_a0 int, got_a1 map[string]map[MyStruct]bool, got_a2 []chan<- <-chan struct{}) []string {
 		errMsgs := prev(got_a0, got_a1, got_a2)
 		if diff := cmp.Diff(a2, got_a2, opts...); diff != "" {
-			errMsgs = append(errMsgs, "parameter #3 mismatch:\n" + diff)
+			errMsgs = append(errMsgs, "parameter #3 mismatch:\n"+diff)
 		}
 		return errMsgs
 	}
@@ -917,7 +729,7 @@ func (d MyInterfaceInCustomFileShouldBeFunMockDescriptorWith2Args) AndMatching(m
 	d.methodDesc.argValidator = func(got_a0 int, got_a1 map[string]map[MyStruct]bool, got_a2 []chan<- <-chan struct{}) []string {
 		errMsgs := prev(got_a0, got_a1, got_a2)
 		if err := match(got_a2); err != nil {
-			errMsgs = append(errMsgs, "parameter \"a2\" custom matcher error: " + err.Error())
+			errMsgs = append(errMsgs, "parameter \"a2\" custom matcher error: "+err.Error())
 		}
 		return errMsgs
 	}
@@ -928,133 +740,43 @@ func (d MyInterfaceInCustomFileShouldBeFunMockDescriptorWith2Args) AndMatching(m
 // method MyInterfaceInCustomFile.ShouldBeFun is expected to be called, with 3
 // arguments specified.
 //
-// It has methods to describe the next argument, if there's
-// any left, or the return values, if there are any, or the times it's expected
-// to be called otherwise.
+// It has methods to describe the next argument, if there's any left, or the
+// return values otherwise.
 type MyInterfaceInCustomFileShouldBeFunMockDescriptorWith3Args struct {
 	methodDesc *MyInterfaceInCustomFileShouldBeFunMockDescriptor
 }
-	
+
 // Returns lets you specify the values that the mocked method MyInterfaceInCustomFile.ShouldBeFun,
 // if called with values matching the expectations, will return.
-func (d MyInterfaceInCustomFileShouldBeFunMockDescriptorWith3Args) Returns(r0 int, r1 error) MyInterfaceInCustomFileShouldBeFunMockDescriptorWithReturn {
+func (d MyInterfaceInCustomFileShouldBeFunMockDescriptorWith3Args) Returns(r0 int, r1 error) MyInterfaceInCustomFileMockDescribedCall {
 	return d.ReturnsFrom(func(int, map[string]map[MyStruct]bool, []chan<- <-chan struct{}) (int, error) {
 		return r0, r1
 	})
 }
 
-// Returns lets you specify the values that the mocked method MyInterfaceInCustomFile.ShouldBeFun,
+// ReturnsFrom lets you specify the values that the mocked method MyInterfaceInCustomFile.ShouldBeFun,
 // if called with values matching the expectations, will return.
-// 
-// It passes such passed values to a function that then returns the return values. 
-func (d MyInterfaceInCustomFileShouldBeFunMockDescriptorWith3Args) ReturnsFrom(f func(a0 int, a1 map[string]map[MyStruct]bool, a2 []chan<- <-chan struct{}) (r0 int, r1 error)) MyInterfaceInCustomFileShouldBeFunMockDescriptorWithReturn {
+//
+// It passes such passed values to a function that then returns the return values.
+func (d MyInterfaceInCustomFileShouldBeFunMockDescriptorWith3Args) ReturnsFrom(f func(a0 int, a1 map[string]map[MyStruct]bool, a2 []chan<- <-chan struct{}) (r0 int, r1 error)) MyInterfaceInCustomFileMockDescribedCall {
 	d.methodDesc.call = f
-	return MyInterfaceInCustomFileShouldBeFunMockDescriptorWithReturn{d.methodDesc}
+	return d.methodDesc.done()
 }
 
-// MyInterfaceInCustomFileShouldBeFunMockDescriptorWithReturn is a step forward in the description of a way that
-// method MyInterfaceInCustomFile.ShouldBeFun is to behave when called, with all expected parameters
-// and the resulting values specified.
-// arguments specified.
-// 
-// It has methods to describe the times the method is expected to be called,
-// or you can start another method call description, or you can call Mock to
-// end the description and get the resulting mock.
-type MyInterfaceInCustomFileShouldBeFunMockDescriptorWithReturn struct {
-	methodDesc *MyInterfaceInCustomFileShouldBeFunMockDescriptor
-}
-	
-// Times lets you specify a exact number of times this method is expected to be
-// called.
-func (d MyInterfaceInCustomFileShouldBeFunMockDescriptorWithReturn) Times(times int) MyInterfaceInCustomFileMockDescriptor {
-	return d.TimesMatching(func(got int) error {
-		if got != times {
-			return fmt.Errorf("expected exactly %d calls, got %d", times, got)
-		}
-		return nil
-	})
-}
-
-// AtLeastTimes lets you specify a minimum number of times this method is expected to be
-// called.
-func (d MyInterfaceInCustomFileShouldBeFunMockDescriptorWithReturn) AtLeastTimes(times int) MyInterfaceInCustomFileMockDescriptor {
-	return d.TimesMatching(func(got int) error {
-		if got < times {
-			return fmt.Errorf("expected at least %d calls, got %d", times, got)
-		}
-		return nil
-	})
-}
-
-// TimesMatching lets you pass a function to accept or reject the number of times
-// this method has been called.
-func (d MyInterfaceInCustomFileShouldBeFunMockDescriptorWithReturn) TimesMatching(f func(times int) error) MyInterfaceInCustomFileMockDescriptor {
-	d.methodDesc.times = f
-	d.methodDesc.done()
-	return d.methodDesc.mockDesc
-}
-
-// Mock finishes the description and produces a mock.
-//
-// See MyInterfaceInCustomFileMockDescriptor.Mock for details.
-func (d MyInterfaceInCustomFileShouldBeFunMockDescriptorWithReturn) Mock() (m MyInterfaceInCustomFileMock, assert func(t interface{ Errorf(string, ...interface{})  }) (ok bool)) {
-	d.methodDesc.done()
-	return d.methodDesc.mockDesc.Mock()
-}
-	
-// Boring finishes the current description for method MyInterfaceInCustomFile.ShouldBeFun and
-// starts describing for method Boring.
-//
-// See MyInterfaceInCustomFileMockDescriptor.Boring for details.
-func (d MyInterfaceInCustomFileShouldBeFunMockDescriptorWithReturn) Boring() *MyInterfaceInCustomFileBoringMockDescriptor {
-	d.methodDesc.done()
-	return d.methodDesc.mockDesc.newMyInterfaceInCustomFileBoringMockDescriptor()
-}
-	
-// EmbeddedMethod finishes the current description for method MyInterfaceInCustomFile.ShouldBeFun and
-// starts describing for method EmbeddedMethod.
-//
-// See MyInterfaceInCustomFileMockDescriptor.EmbeddedMethod for details.
-func (d MyInterfaceInCustomFileShouldBeFunMockDescriptorWithReturn) EmbeddedMethod() *MyInterfaceInCustomFileEmbeddedMethodMockDescriptor {
-	d.methodDesc.done()
-	return d.methodDesc.mockDesc.newMyInterfaceInCustomFileEmbeddedMethodMockDescriptor()
-}
-	
-// ReturnSomethingAtLeast finishes the current description for method MyInterfaceInCustomFile.ShouldBeFun and
-// starts describing for method ReturnSomethingAtLeast.
-//
-// See MyInterfaceInCustomFileMockDescriptor.ReturnSomethingAtLeast for details.
-func (d MyInterfaceInCustomFileShouldBeFunMockDescriptorWithReturn) ReturnSomethingAtLeast() *MyInterfaceInCustomFileReturnSomethingAtLeastMockDescriptor {
-	d.methodDesc.done()
-	return d.methodDesc.mockDesc.newMyInterfaceInCustomFileReturnSomethingAtLeastMockDescriptor()
-}
-	
-// ShouldBeFun finishes the current description for method MyInterfaceInCustomFile.ShouldBeFun and
-// starts describing for method ShouldBeFun.
-//
-// See MyInterfaceInCustomFileMockDescriptor.ShouldBeFun for details.
-func (d MyInterfaceInCustomFileShouldBeFunMockDescriptorWithReturn) ShouldBeFun() *MyInterfaceInCustomFileShouldBeFunMockDescriptor {
-	d.methodDesc.done()
-	return d.methodDesc.mockDesc.newMyInterfaceInCustomFileShouldBeFunMockDescriptor()
-}
-	
-// StdSomething finishes the current description for method MyInterfaceInCustomFile.ShouldBeFun and
-// starts describing for method StdSomething.
-//
-// See MyInterfaceInCustomFileMockDescriptor.StdSomething for details.
-func (d MyInterfaceInCustomFileShouldBeFunMockDescriptorWithReturn) StdSomething() *MyInterfaceInCustomFileStdSomethingMockDescriptor {
-	d.methodDesc.done()
-	return d.methodDesc.mockDesc.newMyInterfaceInCustomFileStdSomethingMockDescriptor()
-}
-	
-func (d *MyInterfaceInCustomFileShouldBeFunMockDescriptor) done() {
+// done ends the description, adding it to the mock descriptor.
+func (d *MyInterfaceInCustomFileShouldBeFunMockDescriptor) done() MyInterfaceInCustomFileMockDescribedCall {
 	d.mockDesc.descriptors_ShouldBeFun = append(d.mockDesc.descriptors_ShouldBeFun, d)
+	return MyInterfaceInCustomFileMockDescribedCall{d.mockDesc, &d.times}
 }
-	
+
 // StdSomething starts describing a way method MyInterfaceInCustomFile.StdSomething is expected to be called
 // and what it should return.
 //
 // You can call it several times to describe different behaviors, each matching different parameters.
+//
+// MyInterface.StdSomething is documented as follows.
+//
+// StdSomething takes a type from the standard library.
 func (d MyInterfaceInCustomFileMockDescriptor) StdSomething() *MyInterfaceInCustomFileStdSomethingMockDescriptor {
 	return d.newMyInterfaceInCustomFileStdSomethingMockDescriptor()
 }
@@ -1062,26 +784,26 @@ func (d MyInterfaceInCustomFileMockDescriptor) StdSomething() *MyInterfaceInCust
 func (d MyInterfaceInCustomFileMockDescriptor) newMyInterfaceInCustomFileStdSomethingMockDescriptor() *MyInterfaceInCustomFileStdSomethingMockDescriptor {
 	_, file, line, _ := runtime.Caller(2)
 	return &MyInterfaceInCustomFileStdSomethingMockDescriptor{
-		mockDesc: d,
-		times: func(int) error { return nil },
+		mockDesc:     d,
+		times:        func(int) error { return nil },
 		argValidator: func(got_f *os.File, got_ints []int) []string { return nil },
-		fileLine: fmt.Sprintf("%s:%d", file, line),
+		fileLine:     fmt.Sprintf("%s:%d", file, line),
 	}
 }
 
 // MyInterfaceInCustomFileStdSomethingMockDescriptor is returned by MyInterfaceInCustomFileMockDescriptor.StdSomething and
 // holds methods to describe the mock for method MyInterfaceInCustomFile.StdSomething.
 type MyInterfaceInCustomFileStdSomethingMockDescriptor struct {
-	mockDesc MyInterfaceInCustomFileMockDescriptor
-	times func(int) error
+	mockDesc     MyInterfaceInCustomFileMockDescriptor
+	times        func(int) error
 	argValidator func(got_f *os.File, got_ints []int) []string
-	call func(f *os.File, ints []int) (named bool)
-	fileLine string
+	call         func(f *os.File, ints []int) (named bool)
+	fileLine     string
 }
-	
+
 // Takes lets you specify a value with which the actual value passed to
 // the mocked method MyInterfaceInCustomFile.StdSomething as parameter #1
-// will be compared. 
+// will be compared.
 //
 // Package "github.com/google/go-cmp/cmp" is used to do the comparison. You can
 // pass extra options for it.
@@ -1094,7 +816,7 @@ func (d *MyInterfaceInCustomFileStdSomethingMockDescriptor) Takes(f *os.File, op
 	d.argValidator = func(got_f *os.File, got_ints []int) []string {
 		errMsgs := prev(got_f, got_ints)
 		if diff := cmp.Diff(f, got_f, opts...); diff != "" {
-			errMsgs = append(errMsgs, "parameter #1 mismatch:\n" + diff)
+			errMsgs = append(errMsgs, "parameter #1 mismatch:\n"+diff)
 		}
 		return errMsgs
 	}
@@ -1114,7 +836,7 @@ func (d *MyInterfaceInCustomFileStdSomethingMockDescriptor) TakesMatching(match
 	d.argValidator = func(got_f *os.File, got_ints []int) []string {
 		errMsgs := prev(got_f, got_ints)
 		if err := match(got_f); err != nil {
-			errMsgs = append(errMsgs, "parameter \"f\" custom matcher error: " + err.Error())
+			errMsgs = append(errMsgs, "parameter \"f\" custom matcher error: "+err.Error())
 		}
 		return errMsgs
 	}
@@ -1125,16 +847,15 @@ func (d *MyInterfaceInCustomFileStdSomethingMockDescriptor) TakesMatching(match
 // method MyInterfaceInCustomFile.StdSomething is expected to be called, with 1
 // arguments specified.
 //
-// It has methods to describe the next argument, if there's
-// any left, or the return values, if there are any, or the times it's expected
-// to be called otherwise.
+// It has methods to describe the next argument, if there's any left, or the
+// return values otherwise.
 type MyInterfaceInCustomFileStdSomethingMockDescriptorWith1Arg struct {
 	methodDesc *MyInterfaceInCustomFileStdSomethingMockDescriptor
 }
-	
+
 // And lets you specify a value with which the actual value passed to
 // the mocked method MyInterfaceInCustomFile.StdSomething as parameter #2
-// will be compared. 
+// will be compared.
 //
 // Package "github.com/google/go-cmp/cmp" is used to do the comparison. You can
 // pass extra options for it.
@@ -1147,7 +868,7 @@ func (d MyInterfaceInCustomFileStdSomethingMockDescriptorWith1Arg) And(ints []in
 	d.methodDesc.argValidator = func(got_f *os.File, got_ints []int) []string {
 		errMsgs := prev(got_f, got_ints)
 		if diff := cmp.Diff(ints, got_ints, opts...); diff != "" {
-			errMsgs = append(errMsgs, "parameter #2 mismatch:\n" + diff)
+			errMsgs = append(errMsgs, "parameter #2 mismatch:\n"+diff)
 		}
 		return errMsgs
 	}
@@ -1167,7 +888,7 @@ func (d MyInterfaceInCustomFileStdSomethingMockDescriptorWith1Arg) AndMatching(m
 	d.methodDesc.argValidator = func(got_f *os.File, got_ints []int) []string {
 		errMsgs := prev(got_f, got_ints)
 		if err := match(got_ints); err != nil {
-			errMsgs = append(errMsgs, "parameter \"ints\" custom matcher error: " + err.Error())
+			errMsgs = append(errMsgs, "parameter \"ints\" custom matcher error: "+err.Error())
 		}
 		return errMsgs
 	}
@@ -1178,129 +899,35 @@ func (d MyInterfaceInCustomFileStdSomethingMockDescriptorWith1Arg) AndMatching(m
 // method MyInterfaceInCustomFile.StdSomething is expected to be called, with 2
 // arguments specified.
 //
-// It has methods to describe the next argument, if there's
-// any left, or the return values, if there are any, or the times it's expected
-// to be called otherwise.
+// It has methods to describe the next argument, if there's any left, or the
+// return values otherwise.
 type MyInterfaceInCustomFileStdSomethingMockDescriptorWith2Args struct {
 	methodDesc *MyInterfaceInCustomFileStdSomethingMockDescriptor
 }
-	
+
 // Returns lets you specify the values that the mocked method MyInterfaceInCustomFile.StdSomething,
 // if called with values matching the expectations, will return.
-func (d MyInterfaceInCustomFileStdSomethingMockDescriptorWith2Args) Returns(named bool) MyInterfaceInCustomFileStdSomethingMockDescriptorWithReturn {
+func (d MyInterfaceInCustomFileStdSomethingMockDescriptorWith2Args) Returns(named bool) MyInterfaceInCustomFileMockDescribedCall {
 	return d.ReturnsFrom(func(*os.File, []int) bool {
 		return named
 	})
 }
 
-// Returns lets you specify the values that the mocked method MyInterfaceInCustomFile.StdSomething,
+// ReturnsFrom lets you specify the values that the mocked method MyInterfaceInCustomFile.StdSomething,
 // if called with values matching the expectations, will return.
-// 
-// It passes such passed values to a function that then returns the return values. 
-func (d MyInterfaceInCustomFileStdSomethingMockDescriptorWith2Args) ReturnsFrom(f func(f *os.File, ints []int) (named bool)) MyInterfaceInCustomFileStdSomethingMockDescriptorWithReturn {
+//
+// It passes such passed values to a function that then returns the return values.
+func (d MyInterfaceInCustomFileStdSomethingMockDescriptorWith2Args) ReturnsFrom(f func(f *os.File, ints []int) (named bool)) MyInterfaceInCustomFileMockDescribedCall {
 	d.methodDesc.call = f
-	return MyInterfaceInCustomFileStdSomethingMockDescriptorWithReturn{d.methodDesc}
+	return d.methodDesc.done()
 }
 
-// MyInterfaceInCustomFileStdSomethingMockDescriptorWithReturn is a step forward in the description of a way that
-// method MyInterfaceInCustomFile.StdSomething is to behave when called, with all expected parameters
-// and the resulting values specified.
-// arguments specified.
-// 
-// It has methods to describe the times the method is expected to be called,
-// or you can start another method call description, or you can call Mock to
-// end the description and get the resulting mock.
-type MyInterfaceInCustomFileStdSomethingMockDescriptorWithReturn struct {
-	methodDesc *MyInterfaceInCustomFileStdSomethingMockDescriptor
-}
-	
-// Times lets you specify a exact number of times this method is expected to be
-// called.
-func (d MyInterfaceInCustomFileStdSomethingMockDescriptorWithReturn) Times(times int) MyInterfaceInCustomFileMockDescriptor {
-	return d.TimesMatching(func(got int) error {
-		if got != times {
-			return fmt.Errorf("expected exactly %d calls, got %d", times, got)
-		}
-		return nil
-	})
-}
-
-// AtLeastTimes lets you specify a minimum number of times this method is expected to be
-// called.
-func (d MyInterfaceInCustomFileStdSomethingMockDescriptorWithReturn) AtLeastTimes(times int) MyInterfaceInCustomFileMockDescriptor {
-	return d.TimesMatching(func(got int) error {
-		if got < times {
-			return fmt.Errorf("expected at least %d calls, got %d", times, got)
-		}
-		return nil
-	})
-}
-
-// TimesMatching lets you pass a function to accept or reject the number of times
-// this method has been called.
-func (d MyInterfaceInCustomFileStdSomethingMockDescriptorWithReturn) TimesMatching(f func(times int) error) MyInterfaceInCustomFileMockDescriptor {
-	d.methodDesc.times = f
-	d.methodDesc.done()
-	return d.methodDesc.mockDesc
-}
-
-// Mock finishes the description and produces a mock.
-//
-// See MyInterfaceInCustomFileMockDescriptor.Mock for details.
-func (d MyInterfaceInCustomFileStdSomethingMockDescriptorWithReturn) Mock() (m MyInterfaceInCustomFileMock, assert func(t interface{ Errorf(string, ...interface{})  }) (ok bool)) {
-	d.methodDesc.done()
-	return d.methodDesc.mockDesc.Mock()
-}
-	
-// Boring finishes the current description for method MyInterfaceInCustomFile.StdSomething and
-// starts describing for method Boring.
-//
-// See MyInterfaceInCustomFileMockDescriptor.Boring for details.
-func (d MyInterfaceInCustomFileStdSomethingMockDescriptorWithReturn) Boring() *MyInterfaceInCustomFileBoringMockDescriptor {
-	d.methodDesc.done()
-	return d.methodDesc.mockDesc.newMyInterfaceInCustomFileBoringMockDescriptor()
-}
-	
-// EmbeddedMethod finishes the current description for method MyInterfaceInCustomFile.StdSomething and
-// starts describing for method EmbeddedMethod.
-//
-// See MyInterfaceInCustomFileMockDescriptor.EmbeddedMethod for details.
-func (d MyInterfaceInCustomFileStdSomethingMockDescriptorWithReturn) EmbeddedMethod() *MyInterfaceInCustomFileEmbeddedMethodMockDescriptor {
-	d.methodDesc.done()
-	return d.methodDesc.mockDesc.newMyInterfaceInCustomFileEmbeddedMethodMockDescriptor()
-}
-	
-// ReturnSomethingAtLeast finishes the current description for method MyInterfaceInCustomFile.StdSomething and
-// starts describing for method ReturnSomethingAtLeast.
-//
-// See MyInterfaceInCustomFileMockDescriptor.ReturnSomethingAtLeast for details.
-func (d MyInterfaceInCustomFileStdSomethingMockDescriptorWithReturn) ReturnSomethingAtLeast() *MyInterfaceInCustomFileReturnSomethingAtLeastMockDescriptor {
-	d.methodDesc.done()
-	return d.methodDesc.mockDesc.newMyInterfaceInCustomFileReturnSomethingAtLeastMockDescriptor()
-}
-	
-// ShouldBeFun finishes the current description for method MyInterfaceInCustomFile.StdSomething and
-// starts describing for method ShouldBeFun.
-//
-// See MyInterfaceInCustomFileMockDescriptor.ShouldBeFun for details.
-func (d MyInterfaceInCustomFileStdSomethingMockDescriptorWithReturn) ShouldBeFun() *MyInterfaceInCustomFileShouldBeFunMockDescriptor {
-	d.methodDesc.done()
-	return d.methodDesc.mockDesc.newMyInterfaceInCustomFileShouldBeFunMockDescriptor()
-}
-	
-// StdSomething finishes the current description for method MyInterfaceInCustomFile.StdSomething and
-// starts describing for method StdSomething.
-//
-// See MyInterfaceInCustomFileMockDescriptor.StdSomething for details.
-func (d MyInterfaceInCustomFileStdSomethingMockDescriptorWithReturn) StdSomething() *MyInterfaceInCustomFileStdSomethingMockDescriptor {
-	d.methodDesc.done()
-	return d.methodDesc.mockDesc.newMyInterfaceInCustomFileStdSomethingMockDescriptor()
-}
-	
-func (d *MyInterfaceInCustomFileStdSomethingMockDescriptor) done() {
+// done ends the description, adding it to the mock descriptor.
+func (d *MyInterfaceInCustomFileStdSomethingMockDescriptor) done() MyInterfaceInCustomFileMockDescribedCall {
 	d.mockDesc.descriptors_StdSomething = append(d.mockDesc.descriptors_StdSomething, d)
+	return MyInterfaceInCustomFileMockDescribedCall{d.mockDesc, &d.times}
 }
-	
+
 // Mock returns a mock for MyInterface that calls the functions
 // defined as struct fields in the receiver.
 //
@@ -1338,9 +965,14 @@ func (m _makegomock_MyInterfaceInCustomFileMockFromMocker) StdSomething(f *os.Fi
 // It is copied from the original just to avoid introducing a dependency on
 // MyInterface's package.
 type MyInterfaceInCustomFileMock interface {
+	// Boring takes nothing and returns nothing.
 	Boring()
 	EmbeddedMethod()
+	// ReturnSomethingAtLeast returns an int, which is something at least.
 	ReturnSomethingAtLeast() (r0 int)
+	// ShouldBeFun takes some complex types, and a variadic argument. Its
+	// parameters are unnamed, so they get synthetic names in the mock.
 	ShouldBeFun(a0 int, a1 map[string]map[MyStruct]bool, a2 ...chan<- <-chan struct{}) (r0 int, r1 error)
+	// StdSomething takes a type from the standard library.
 	StdSomething(f *os.File, ints ...int) (named bool)
 }
@@ -10,7 +10,11 @@
 package examples
 
 import (
+	"context"
+	"fmt"
+	"net/http"
 	"os"
+	"time"
 )
 
 //go:generate make.go.mock -v -type MyInterface -dst mock_MyInterface_test.go
@@ -19,12 +23,18 @@ import (
 //go:generate make.go.mock -v -type MyInterface -as MyInterfaceInCustomFile -dst custom_file_name_test.go
 //go:generate make.go.mock -v -type MyInterface -dst generated/generated.go
 //go:generate make.go.mock -v -type MyInterface -dst generated -dstpkg generated_test -bare
+//go:generate make.go.mock -v -config makegomock.json
 
 type MyInterface interface {
 	Embedded
+	// Boring takes nothing and returns nothing.
 	Boring()
+	// ReturnSomethingAtLeast returns an int, which is something at least.
 	ReturnSomethingAtLeast() int
+	// ShouldBeFun takes some complex types, and a variadic argument. Its
+	// parameters are unnamed, so they get synthetic names in the mock.
 	ShouldBeFun(int, map[string]map[MyStruct]bool, ...chan<- <-chan struct{}) (int, error)
+	// StdSomething takes a type from the standard library.
 	StdSomething(f *os.File, ints ...int) (named bool)
 }
 
@@ -37,13 +47,177 @@ type MyStruct struct {
 	File      *os.File
 }
 
-//go:generate make.go.mock -v -type MyFunc -dst generated/generated_func.go
-
 type MyFunc func(a, b, c int, x bool, multi ...MyStruct) (ok bool, err error)
 
-//go:generate make.go.mock -v -type KeyValuesRepository -dst mock_KeyValuesRepository.go
-
 type KeyValuesRepository interface {
 	Get(key string) (int, error)
 	Put(key string, value int) error
 }
+
+//go:generate make.go.mock -v -type Repository,Repository[string,int]=StringIntRepository,Mapper -dst mock_generics_test.go -assert
+//go:generate make.go.mock -v -type MyInterface=MyInterfaceWithRuntime,Repository=RepositoryWithRuntime,Shadowing=ShadowingWithRuntime,ShadowingTypeParams=ShadowingTypeParamsWithRuntime -dst mock_runtime_test.go -runtime -assert
+
+type Repository[K comparable, V any] interface {
+	Get(key K) (V, error)
+	Put(key K, value V) error
+	Keys() []K
+}
+
+type Mapper[T any, U ~string] func(T) (U, error)
+
+//go:generate make.go.mock -v -src net/http -type RoundTripper
+
+//go:generate make.go.mock -v -type *Store -dst generated/mock_Store.go -iface -assert
+
+// Store is a concrete type. Its mock is built from its exported methods, and
+// -iface declares an interface with them for code to depend on.
+type Store struct {
+	data map[string]string
+}
+
+// Load returns the value stored for key, if any.
+func (s *Store) Load(key string) (string, bool) {
+	v, ok := s.data[key]
+	return v, ok
+}
+
+// Save stores value for key, replacing the previous one.
+func (s *Store) Save(key, value string) error {
+	if s.data == nil {
+		s.data = map[string]string{}
+	}
+	s.data[key] = value
+	return nil
+}
+
+// Len returns the number of stored keys.
+func (s Store) Len() int {
+	return len(s.data)
+}
+
+func (s *Store) reset() {
+	s.data = nil
+}
+
+//go:generate make.go.mock -v -tags integration -buildtag integration -type Notifier -dst mock_Notifier_test.go
+
+//go:generate make.go.mock -v -type Shadowing,ShadowingGeneric,ShadowingTypeParams,ShadowingInstall -dst mock_shadowing_test.go
+
+// Shadowing has parameters that would clash with identifiers in the generated
+// code if they weren't renamed.
+type Shadowing interface {
+	// Locals are renamed, as they would shadow the generated code's local
+	// variables.
+	Locals(prev int, desc string, matching bool, calls int) (d int, m int)
+	// Blanks get synthetic names.
+	Blanks(_ int, _ string) (_ error)
+	// Predeclared are renamed, as they would shadow predeclared identifiers.
+	Predeclared(string string, len int, error bool) (true bool)
+	// Qualifiers are renamed, as they would shadow imported packages.
+	Qualifiers(os *os.File, fmt string, cmp int)
+	// Validator's got_x is renamed, as it would clash with the name given to
+	// x in argument validators.
+	Validator(got_x int, x int)
+	// Synthetic names are renamed if they are already taken.
+	Synthetic(a1 int, _ string, r0 bool) (int, error)
+	// LocalTypes are renamed, as they would shadow types that the mock refers
+	// to unqualified, since it's in the same package.
+	LocalTypes(User User, Store *Store)
+	// Kept are kept, since they don't clash with anything.
+	Kept(i int, arg string, err error)
+}
+
+// ShadowingGeneric has a parameter named after a type parameter.
+type ShadowingGeneric[T any] interface {
+	Get(T T) T
+}
+
+// ShadowingTypeParams has type parameters named like packages that the
+// generated code imports, which get other names instead.
+type ShadowingTypeParams[fmt any, cmp comparable, mockrt any] interface {
+	Get(key cmp) (fmt, mockrt)
+}
+
+// ShadowingInstall has type parameters named like the parameters of Install,
+// which get other names instead.
+type ShadowingInstall[t any, target any] func(t) target
+
+//go:generate make.go.mock -v -all -exclude ^Shadowing -bare -dst allmocks/mocks.go
+
+// Number is a constraint interface, so it can't be mocked. -all skips it with a
+// warning.
+type Number interface {
+	~int | ~float64
+}
+
+//go:generate make.go.mock -v -type Any -dst mock_Any_test.go -assert
+
+// Any has no methods, like the marker interfaces that -all picks up. Its mock
+// has no methods to describe either.
+type Any interface{}
+
+//go:generate make.go.mock -v -type BigClient -methods Get,Put -dst mock_BigClient_test.go -assert
+//go:generate make.go.mock -v -type BigClient=BigClientWithoutWatch -exclude-methods Watch -bare -dst mock_BigClientWithoutWatch_test.go
+
+// BigClient stands for a client with many methods, of which tests only use a
+// few. Only those are mocked with -methods; the rest panic if called.
+type BigClient interface {
+	Get(key string) (string, error)
+	Put(key, value string) error
+	Delete(key string) error
+	List(prefix string) ([]string, error)
+	Watch(key string, f func(value string)) (stop func())
+}
+
+//go:generate make.go.mock -v -type Transport,KV,Handler,Getter -dst mock_aliases_test.go -assert
+
+// Transport is an alias for a type in another package. Its mock is named after
+// the alias.
+type Transport = http.RoundTripper
+
+// KV is an alias for another type in this package.
+type KV = KeyValuesRepository
+
+// Handler is an alias for a function type literal.
+type Handler = func(key string) error
+
+// Getter is an alias for an interface type literal.
+type Getter = interface {
+	Get(key string) (int, error)
+}
+
+//go:generate make.go.mock -v -func FetchUser,(*Store).Load,KeyValuesRepository.Get=GetFromRepository -dst mock_funcs_test.go -assert
+
+// User is returned by FetchUser.
+type User struct {
+	ID   string
+	Name string
+}
+
+// FetchUser is a function that code may call through a variable, so that tests
+// can replace it with a mock. -func mocks it as a function type with its
+// signature.
+func FetchUser(ctx context.Context, id string) (*User, error) {
+	return nil, fmt.Errorf("user %s not found", id)
+}
+
+//go:generate make.go.mock -v -type Options.OnRetry,Options.Retry.OnGiveUp,Options.Logger -dst mock_fields_test.go -assert
+
+// Options holds callbacks that tests may want to replace with mocks. -type
+// mocks a field's type given its path, like Options.OnRetry, naming the mock
+// after the path.
+type Options struct {
+	// OnRetry is a function type literal.
+	OnRetry func(attempt int, err error) (wait time.Duration)
+	// Retry is a nested struct.
+	Retry struct {
+		OnGiveUp func(err error)
+	}
+	// Logger is a named type.
+	Logger Logger
+}
+
+// Logger is the type of Options.Logger.
+type Logger interface {
+	Log(msg string)
+}
@@ -0,0 +1,58 @@
+package examples
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMockField(t *testing.T) {
+	errFailed := errors.New("failed")
+	mock, assertMock := (&OptionsOnRetryMocker{}).Describe().
+		Func().Takes(2).AndAny().Returns(time.Second).Times(1).
+		Mock()
+	defer assertMock(t)
+
+	opts := Options{OnRetry: mock}
+
+	assert.Equal(t, time.Second, opts.OnRetry(2, errFailed))
+}
+
+func TestMockNestedField(t *testing.T) {
+	var gaveUp error
+	opts := Options{}
+	opts.Retry.OnGiveUp = (&OptionsRetryOnGiveUpMocker{
+		Func: func(err error) { gaveUp = err },
+	}).Mock()
+
+	errFailed := errors.New("failed")
+	opts.Retry.OnGiveUp(errFailed)
+	assert.Equal(t, errFailed, gaveUp)
+}
+
+func TestMockFieldOfNamedType(t *testing.T) {
+	mock, assertMock := (&OptionsLoggerMocker{}).Describe().
+		Log().Takes("hello").Times(1).
+		Mock()
+	defer assertMock(t)
+
+	opts := Options{Logger: mock}
+
+	opts.Logger.Log("hello")
+}
+
+func TestInstallField(t *testing.T) {
+	opts := &Options{}
+	ct := &cleanupT{}
+	(&OptionsOnRetryMocker{}).Describe().
+		Func().TakesAny().AndAny().Returns(time.Minute).Times(1).
+		Install(&opts.OnRetry, ct)
+
+	assert.Equal(t, time.Minute, opts.OnRetry(1, nil))
+
+	ct.cleanup()
+	assert.Nil(t, opts.OnRetry)
+	assert.Empty(t, ct.errs)
+}
@@ -0,0 +1,35 @@
+package examples
+
+import (
+	"bytes"
+	"go/format"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGeneratedCodeIsFormatted(t *testing.T) {
+	paths, err := filepath.Glob("*.go")
+	assert.NoError(t, err)
+	more, err := filepath.Glob("generated/*.go")
+	assert.NoError(t, err)
+	paths = append(paths, more...)
+
+	header := []byte("// Code generated by github.com/tcard/make.go.mock. DO NOT EDIT.")
+	checked := 0
+	for _, path := range paths {
+		src, err := os.ReadFile(path)
+		assert.NoError(t, err)
+		if !bytes.Contains(src, header) {
+			continue
+		}
+		checked++
+		formatted, err := format.Source(src)
+		if assert.NoError(t, err, path) {
+			assert.Equal(t, string(formatted), string(src), "%s isn't gofmt-clean", path)
+		}
+	}
+	assert.NotZero(t, checked)
+}
@@ -0,0 +1,49 @@
+package examples
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMockFunction(t *testing.T) {
+	user := &User{ID: "42", Name: "Alice"}
+	mock, assertMock := (&FetchUserMocker{}).Describe().
+		Func().TakesAny().And("42").Returns(user, nil).Times(1).
+		Mock()
+	defer assertMock(t)
+
+	// Code calls the function through a variable, which tests replace.
+	fetchUser := FetchUser
+	fetchUser = mock
+
+	got, err := fetchUser(context.Background(), "42")
+	assert.NoError(t, err)
+	assert.Equal(t, user, got)
+}
+
+func TestMockMethodExpression(t *testing.T) {
+	store := &Store{}
+	mock, assertMock := (&StoreLoadMocker{}).Describe().
+		Func().TakesAny().And("foo").Returns("bar", true).Times(1).
+		Mock()
+	defer assertMock(t)
+
+	load := (*Store).Load
+	load = mock
+
+	value, ok := load(store, "foo")
+	assert.True(t, ok)
+	assert.Equal(t, "bar", value)
+}
+
+func TestMockInterfaceMethodExpression(t *testing.T) {
+	var get func(KeyValuesRepository, string) (int, error) = (&GetFromRepositoryMocker{
+		Func: func(_ KeyValuesRepository, key string) (int, error) { return len(key), nil },
+	}).Mock()
+
+	v, err := get(nil, "foo")
+	assert.NoError(t, err)
+	assert.Equal(t, 3, v)
+}
@@ -3,3 +3,7 @@
 //
 // Check out MyInterfaceMocker.
 package generated
+
+// Mocks can be generated outside the package's directory, into any package in
+// the module.
+//go:generate make.go.mock -v -src github.com/tcard/make.go.mock/examples -type KeyValuesRepository -dst ../sharedmocks/keyvalues.go -assert
@@ -3,11 +3,12 @@
 package generated
 
 import (
-	fmt "fmt"
-	cmp "github.com/google/go-cmp/cmp"
-	examples "github.com/tcard/make.go.mock/examples"
-	os "os"
-	runtime "runtime"
+	"fmt"
+	"os"
+	"runtime"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/tcard/make.go.mock/examples"
 )
 
 // MyInterfaceMocker builds mocks for type MyInterface.
@@ -21,11 +22,16 @@ import (
 // The Describe method is a shortcut to define this struct's fields in a
 // declarative manner.
 type MyInterfaceMocker struct {
-	Boring                 func()
-	EmbeddedMethod         func()
+	// Boring takes nothing and returns nothing.
+	Boring         func()
+	EmbeddedMethod func()
+	// ReturnSomethingAtLeast returns an int, which is something at least.
 	ReturnSomethingAtLeast func() (r0 int)
-	ShouldBeFun            func(a0 int, a1 map[string]map[examples.MyStruct]bool, a2 ...chan<- <-chan struct{}) (r0 int, r1 error)
-	StdSomething           func(f *os.File, ints ...int) (named bool)
+	// ShouldBeFun takes some complex types, and a variadic argument. Its
+	// parameters are unnamed, so they get synthetic names in the mock.
+	ShouldBeFun func(a0 int, a1 map[string]map[examples.MyStruct]bool, a2 ...chan<- <-chan struct{}) (r0 int, r1 error)
+	// StdSomething takes a type from the standard library.
+	StdSomething func(f *os.File, ints ...int) (named bool)
 }
 
 // Describe lets you describe how the methods on the resulting mock are expected
@@ -43,12 +49,12 @@ func (m *MyInterfaceMocker) Describe() MyInterfaceMockDescriptor {
 // When you're done describing methods, call its Mock method to get a mock that
 // implements the behavior you described.
 type MyInterfaceMockDescriptor struct {
-	m *MyInterfaceMocker
-	descriptors_Boring []*MyInterfaceBoringMockDescriptor
-	descriptors_EmbeddedMethod []*MyInterfaceEmbeddedMethodMockDescriptor
+	m                                  *MyInterfaceMocker
+	descriptors_Boring                 []*MyInterfaceBoringMockDescriptor
+	descriptors_EmbeddedMethod         []*MyInterfaceEmbeddedMethodMockDescriptor
 	descriptors_ReturnSomethingAtLeast []*MyInterfaceReturnSomethingAtLeastMockDescriptor
-	descriptors_ShouldBeFun []*MyInterfaceShouldBeFunMockDescriptor
-	descriptors_StdSomething []*MyInterfaceStdSomethingMockDescriptor
+	descriptors_ShouldBeFun            []*MyInterfaceShouldBeFunMockDescriptor
+	descriptors_StdSomething           []*MyInterfaceStdSomethingMockDescriptor
 }
 
 // Mock returns a mock that the MyInterface interface, following the behavior
@@ -57,18 +63,22 @@ type MyInterfaceMockDescriptor struct {
 // It also returns a function that should be called before the test is done to
 // ensure that the expected number of calls to the mock methods happened. You
 // can pass a *testing.T to it, since it implements the interface it wants.
-func (d MyInterfaceMockDescriptor) Mock() (m MyInterfaceMock, assert func(t interface{ Errorf(s string, args ...interface{})  }) (ok bool)) {
+func (d MyInterfaceMockDescriptor) Mock() (m MyInterfaceMock, assert func(t interface {
+	Errorf(s string, args ...interface{})
+}) (ok bool)) {
 	assert = d.done()
 	return d.m.Mock(), assert
 }
 
-func (d MyInterfaceMockDescriptor) done() func(t interface{ Errorf(s string, args ...interface{})  }) bool {
+func (d MyInterfaceMockDescriptor) done() func(t interface {
+	Errorf(s string, args ...interface{})
+}) bool {
 	var atAssert []func() (method string, errs []string)
 	type specErrs struct {
 		fileLine string
-		errs []string
+		errs     []string
 	}
-	
+
 	if len(d.descriptors_Boring) > 0 {
 		for _, desc := range d.descriptors_Boring {
 			desc := desc
@@ -109,7 +119,7 @@ func (d MyInterfaceMockDescriptor) done() func(t interface{ Errorf(s string, arg
 			if len(matching) == 0 {
 				matchingErrs := ""
 				for _, errs := range allErrs {
-					matchingErrs += "\n\tcandidate described at "+errs.fileLine+":\n"
+					matchingErrs += "\n\tcandidate described at " + errs.fileLine + ":\n"
 					for _, err := range errs.errs {
 						matchingErrs += "\n\t\t" + err
 					}
@@ -167,7 +177,7 @@ func (d MyInterfaceMockDescriptor) done() func(t interface{ Errorf(s string, arg
 			if len(matching) == 0 {
 				matchingErrs := ""
 				for _, errs := range allErrs {
-					matchingErrs += "\n\tcandidate described at "+errs.fileLine+":\n"
+					matchingErrs += "\n\tcandidate described at " + errs.fileLine + ":\n"
 					for _, err := range errs.errs {
 						matchingErrs += "\n\t\t" + err
 					}
@@ -226,7 +236,7 @@ func (d MyInterfaceMockDescriptor) done() func(t interface{ Errorf(s string, arg
 			if len(matching) == 0 {
 				matchingErrs := ""
 				for _, errs := range allErrs {
-					matchingErrs += "\n\tcandidate described at "+errs.fileLine+":\n"
+					matchingErrs += "\n\tcandidate described at " + errs.fileLine + ":\n"
 					for _, err := range errs.errs {
 						matchingErrs += "\n\t\t" + err
 					}
@@ -285,7 +295,7 @@ func (d MyInterfaceMockDescriptor) done() func(t interface{ Errorf(s string, arg
 			if len(matching) == 0 {
 				matchingErrs := ""
 				for _, errs := range allErrs {
-					matchingErrs += "\n\tcandidate described at "+errs.fileLine+":\n"
+					matchingErrs += "\n\tcandidate described at " + errs.fileLine + ":\n"
 					for _, err := range errs.errs {
 						matchingErrs += "\n\t\t" + err
 					}
@@ -344,7 +354,7 @@ func (d MyInterfaceMockDescriptor) done() func(t interface{ Errorf(s string, arg
 			if len(matching) == 0 {
 				matchingErrs := ""
 				for _, errs := range allErrs {
-					matchingErrs += "\n\tcandidate described at "+errs.fileLine+":\n"
+					matchingErrs += "\n\tcandidate described at " + errs.fileLine + ":\n"
 					for _, err := range errs.errs {
 						matchingErrs += "\n\t\t" + err
 					}
@@ -362,7 +372,9 @@ func (d MyInterfaceMockDescriptor) done() func(t interface{ Errorf(s string, arg
 			panic("unexpected call to mock for MyInterface.StdSomething")
 		}
 	}
-	return func(t interface{ Errorf(s string, args ...interface{})  }) bool {
+	return func(t interface {
+		Errorf(s string, args ...interface{})
+	}) bool {
 		ok := true
 		for _, assert := range atAssert {
 			method, errs := assert()
@@ -374,38 +386,23 @@ func (d MyInterfaceMockDescriptor) done() func(t interface{ Errorf(s string, arg
 		return ok
 	}
 }
-	
-// Boring starts describing a way method MyInterface.Boring is expected to be called
-// and what it should return.
-//
-// You can call it several times to describe different behaviors, each matching different parameters.
-func (d MyInterfaceMockDescriptor) Boring() *MyInterfaceBoringMockDescriptor {
-	return d.newMyInterfaceBoringMockDescriptor()
-}
 
-func (d MyInterfaceMockDescriptor) newMyInterfaceBoringMockDescriptor() *MyInterfaceBoringMockDescriptor {
-	_, file, line, _ := runtime.Caller(2)
-	return &MyInterfaceBoringMockDescriptor{
-		mockDesc: d,
-		times: func(int) error { return nil },
-		argValidator: func() []string { return nil },
-		fileLine: fmt.Sprintf("%s:%d", file, line),
-	}
+// MyInterfaceMockDescribedCall is the last step in the description of a way that a
+// method of MyInterface is to behave when called, with all expected parameters
+// and the resulting values specified.
+//
+// It has methods to describe the times the method is expected to be called.
+// Otherwise, through the embedded MyInterfaceMockDescriptor, you can start
+// another method call description, or you can call Mock to end the description
+// and get the resulting mock.
+type MyInterfaceMockDescribedCall struct {
+	MyInterfaceMockDescriptor
+	times *func(int) error
 }
 
-// MyInterfaceBoringMockDescriptor is returned by MyInterfaceMockDescriptor.Boring and
-// holds methods to describe the mock for method MyInterface.Boring.
-type MyInterfaceBoringMockDescriptor struct {
-	mockDesc MyInterfaceMockDescriptor
-	times func(int) error
-	argValidator func() []string
-	call func()
-	fileLine string
-}
-	
 // Times lets you specify a exact number of times this method is expected to be
 // called.
-func (d *MyInterfaceBoringMockDescriptor) Times(times int) MyInterfaceMockDescriptor {
+func (d MyInterfaceMockDescribedCall) Times(times int) MyInterfaceMockDescriptor {
 	return d.TimesMatching(func(got int) error {
 		if got != times {
 			return fmt.Errorf("expected exactly %d calls, got %d", times, got)
@@ -416,7 +413,7 @@ func (d *MyInterfaceBoringMockDescriptor) Times(times int) MyInterfaceMockDescri
 
 // AtLeastTimes lets you specify a minimum number of times this method is expected to be
 // called.
-func (d *MyInterfaceBoringMockDescriptor) AtLeastTimes(times int) MyInterfaceMockDescriptor {
+func (d MyInterfaceMockDescribedCall) AtLeastTimes(times int) MyInterfaceMockDescriptor {
 	return d.TimesMatching(func(got int) error {
 		if got < times {
 			return fmt.Errorf("expected at least %d calls, got %d", times, got)
@@ -427,188 +424,91 @@ func (d *MyInterfaceBoringMockDescriptor) AtLeastTimes(times int) MyInterfaceMoc
 
 // TimesMatching lets you pass a function to accept or reject the number of times
 // this method has been called.
-func (d *MyInterfaceBoringMockDescriptor) TimesMatching(f func(times int) error) MyInterfaceMockDescriptor {
-	d.times = f
-	d.done()
-	return d.mockDesc
+func (d MyInterfaceMockDescribedCall) TimesMatching(f func(times int) error) MyInterfaceMockDescriptor {
+	*d.times = f
+	return d.MyInterfaceMockDescriptor
 }
 
-// Mock finishes the description and produces a mock.
-//
-// See MyInterfaceMockDescriptor.Mock for details.
-func (d *MyInterfaceBoringMockDescriptor) Mock() (m MyInterfaceMock, assert func(t interface{ Errorf(string, ...interface{})  }) (ok bool)) {
-	d.done()
-	return d.mockDesc.Mock()
-}
-	
-// Boring finishes the current description for method MyInterface.Boring and
-// starts describing for method Boring.
+// Boring starts describing a way method MyInterface.Boring is expected to be called
+// and what it should return.
 //
-// See MyInterfaceMockDescriptor.Boring for details.
-func (d *MyInterfaceBoringMockDescriptor) Boring() *MyInterfaceBoringMockDescriptor {
-	d.done()
-	return d.mockDesc.newMyInterfaceBoringMockDescriptor()
-}
-	
-// EmbeddedMethod finishes the current description for method MyInterface.Boring and
-// starts describing for method EmbeddedMethod.
+// You can call it several times to describe different behaviors, each matching different parameters.
 //
-// See MyInterfaceMockDescriptor.EmbeddedMethod for details.
-func (d *MyInterfaceBoringMockDescriptor) EmbeddedMethod() *MyInterfaceEmbeddedMethodMockDescriptor {
-	d.done()
-	return d.mockDesc.newMyInterfaceEmbeddedMethodMockDescriptor()
-}
-	
-// ReturnSomethingAtLeast finishes the current description for method MyInterface.Boring and
-// starts describing for method ReturnSomethingAtLeast.
+// MyInterface.Boring is documented as follows.
 //
-// See MyInterfaceMockDescriptor.ReturnSomethingAtLeast for details.
-func (d *MyInterfaceBoringMockDescriptor) ReturnSomethingAtLeast() *MyInterfaceReturnSomethingAtLeastMockDescriptor {
-	d.done()
-	return d.mockDesc.newMyInterfaceReturnSomethingAtLeastMockDescriptor()
+// Boring takes nothing and returns nothing.
+func (d MyInterfaceMockDescriptor) Boring() MyInterfaceMockDescribedCall {
+	return d.newMyInterfaceBoringMockDescriptor().done()
 }
-	
-// ShouldBeFun finishes the current description for method MyInterface.Boring and
-// starts describing for method ShouldBeFun.
-//
-// See MyInterfaceMockDescriptor.ShouldBeFun for details.
-func (d *MyInterfaceBoringMockDescriptor) ShouldBeFun() *MyInterfaceShouldBeFunMockDescriptor {
-	d.done()
-	return d.mockDesc.newMyInterfaceShouldBeFunMockDescriptor()
+
+func (d MyInterfaceMockDescriptor) newMyInterfaceBoringMockDescriptor() *MyInterfaceBoringMockDescriptor {
+	_, file, line, _ := runtime.Caller(2)
+	return &MyInterfaceBoringMockDescriptor{
+		mockDesc:     d,
+		times:        func(int) error { return nil },
+		argValidator: func() []string { return nil },
+		fileLine:     fmt.Sprintf("%s:%d", file, line),
+	}
 }
-	
-// StdSomething finishes the current description for method MyInterface.Boring and
-// starts describing for method StdSomething.
-//
-// See MyInterfaceMockDescriptor.StdSomething for details.
-func (d *MyInterfaceBoringMockDescriptor) StdSomething() *MyInterfaceStdSomethingMockDescriptor {
-	d.done()
-	return d.mockDesc.newMyInterfaceStdSomethingMockDescriptor()
+
+// MyInterfaceBoringMockDescriptor is returned by MyInterfaceMockDescriptor.Boring and
+// holds methods to describe the mock for method MyInterface.Boring.
+type MyInterfaceBoringMockDescriptor struct {
+	mockDesc     MyInterfaceMockDescriptor
+	times        func(int) error
+	argValidator func() []string
+	call         func()
+	fileLine     string
 }
-	
-func (d *MyInterfaceBoringMockDescriptor) done() {
+
+// done ends the description, adding it to the mock descriptor.
+func (d *MyInterfaceBoringMockDescriptor) done() MyInterfaceMockDescribedCall {
 	d.mockDesc.descriptors_Boring = append(d.mockDesc.descriptors_Boring, d)
+	return MyInterfaceMockDescribedCall{d.mockDesc, &d.times}
 }
-	
+
 // EmbeddedMethod starts describing a way method MyInterface.EmbeddedMethod is expected to be called
 // and what it should return.
 //
 // You can call it several times to describe different behaviors, each matching different parameters.
-func (d MyInterfaceMockDescriptor) EmbeddedMethod() *MyInterfaceEmbeddedMethodMockDescriptor {
-	return d.newMyInterfaceEmbeddedMethodMockDescriptor()
+func (d MyInterfaceMockDescriptor) EmbeddedMethod() MyInterfaceMockDescribedCall {
+	return d.newMyInterfaceEmbeddedMethodMockDescriptor().done()
 }
 
 func (d MyInterfaceMockDescriptor) newMyInterfaceEmbeddedMethodMockDescriptor() *MyInterfaceEmbeddedMethodMockDescriptor {
 	_, file, line, _ := runtime.Caller(2)
 	return &MyInterfaceEmbeddedMethodMockDescriptor{
-		mockDesc: d,
-		times: func(int) error { return nil },
+		mockDesc:     d,
+		times:        func(int) error { return nil },
 		argValidator: func() []string { return nil },
-		fileLine: fmt.Sprintf("%s:%d", file, line),
+		fileLine:     fmt.Sprintf("%s:%d", file, line),
 	}
 }
 
 // MyInterfaceEmbeddedMethodMockDescriptor is returned by MyInterfaceMockDescriptor.EmbeddedMethod and
 // holds methods to describe the mock for method MyInterface.EmbeddedMethod.
 type MyInterfaceEmbeddedMethodMockDescriptor struct {
-	mockDesc MyInterfaceMockDescriptor
-	times func(int) error
+	mockDesc     MyInterfaceMockDescriptor
+	times        func(int) error
 	argValidator func() []string
-	call func()
-	fileLine string
-}
-	
-// Times lets you specify a exact number of times this method is expected to be
-// called.
-func (d *MyInterfaceEmbeddedMethodMockDescriptor) Times(times int) MyInterfaceMockDescriptor {
-	return d.TimesMatching(func(got int) error {
-		if got != times {
-			return fmt.Errorf("expected exactly %d calls, got %d", times, got)
-		}
-		return nil
-	})
-}
-
-// AtLeastTimes lets you specify a minimum number of times this method is expected to be
-// called.
-func (d *MyInterfaceEmbeddedMethodMockDescriptor) AtLeastTimes(times int) MyInterfaceMockDescriptor {
-	return d.TimesMatching(func(got int) error {
-		if got < times {
-			return fmt.Errorf("expected at least %d calls, got %d", times, got)
-		}
-		return nil
-	})
+	call         func()
+	fileLine     string
 }
 
-// TimesMatching lets you pass a function to accept or reject the number of times
-// this method has been called.
-func (d *MyInterfaceEmbeddedMethodMockDescriptor) TimesMatching(f func(times int) error) MyInterfaceMockDescriptor {
-	d.times = f
-	d.done()
-	return d.mockDesc
-}
-
-// Mock finishes the description and produces a mock.
-//
-// See MyInterfaceMockDescriptor.Mock for details.
-func (d *MyInterfaceEmbeddedMethodMockDescriptor) Mock() (m MyInterfaceMock, assert func(t interface{ Errorf(string, ...interface{})  }) (ok bool)) {
-	d.done()
-	return d.mockDesc.Mock()
-}
-	
-// Boring finishes the current description for method MyInterface.EmbeddedMethod and
-// starts describing for method Boring.
-//
-// See MyInterfaceMockDescriptor.Boring for details.
-func (d *MyInterfaceEmbeddedMethodMockDescriptor) Boring() *MyInterfaceBoringMockDescriptor {
-	d.done()
-	return d.mockDesc.newMyInterfaceBoringMockDescriptor()
-}
-	
-// EmbeddedMethod finishes the current description for method MyInterface.EmbeddedMethod and
-// starts describing for method EmbeddedMethod.
-//
-// See MyInterfaceMockDescriptor.EmbeddedMethod for details.
-func (d *MyInterfaceEmbeddedMethodMockDescriptor) EmbeddedMethod() *MyInterfaceEmbeddedMethodMockDescriptor {
-	d.done()
-	return d.mockDesc.newMyInterfaceEmbeddedMethodMockDescriptor()
-}
-	
-// ReturnSomethingAtLeast finishes the current description for method MyInterface.EmbeddedMethod and
-// starts describing for method ReturnSomethingAtLeast.
-//
-// See MyInterfaceMockDescriptor.ReturnSomethingAtLeast for details.
-func (d *MyInterfaceEmbeddedMethodMockDescriptor) ReturnSomethingAtLeast() *MyInterfaceReturnSomethingAtLeastMockDescriptor {
-	d.done()
-	return d.mockDesc.newMyInterfaceReturnSomethingAtLeastMockDescriptor()
-}
-	
-// ShouldBeFun finishes the current description for method MyInterface.EmbeddedMethod and
-// starts describing for method ShouldBeFun.
-//
-// See MyInterfaceMockDescriptor.ShouldBeFun for details.
-func (d *MyInterfaceEmbeddedMethodMockDescriptor) ShouldBeFun() *MyInterfaceShouldBeFunMockDescriptor {
-	d.done()
-	return d.mockDesc.newMyInterfaceShouldBeFunMockDescriptor()
-}
-	
-// StdSomething finishes the current description for method MyInterface.EmbeddedMethod and
-// starts describing for method StdSomething.
-//
-// See MyInterfaceMockDescriptor.StdSomething for details.
-func (d *MyInterfaceEmbeddedMethodMockDescriptor) StdSomething() *MyInterfaceStdSomethingMockDescriptor {
-	d.done()
-	return d.mockDesc.newMyInterfaceStdSomethingMockDescriptor()
-}
-	
-func (d *MyInterfaceEmbeddedMethodMockDescriptor) done() {
+// done ends the description, adding it to the mock descriptor.
+func (d *MyInterfaceEmbeddedMethodMockDescriptor) done() MyInterfaceMockDescribedCall {
 	d.mockDesc.descriptors_EmbeddedMethod = append(d.mockDesc.descriptors_EmbeddedMethod, d)
+	return MyInterfaceMockDescribedCall{d.mockDesc, &d.times}
 }
-	
+
 // ReturnSomethingAtLeast starts describing a way method MyInterface.ReturnSomethingAtLeast is expected to be called
 // and what it should return.
 //
 // You can call it several times to describe different behaviors, each matching different parameters.
+//
+// MyInterface.ReturnSomethingAtLeast is documented as follows.
+//
+// ReturnSomethingAtLeast returns an int, which is something at least.
 func (d MyInterfaceMockDescriptor) ReturnSomethingAtLeast() *MyInterfaceReturnSomethingAtLeastMockDescriptor {
 	return d.newMyInterfaceReturnSomethingAtLeastMockDescriptor()
 }
@@ -616,143 +516,55 @@ func (d MyInterfaceMockDescriptor) ReturnSomethingAtLeast() *MyInterfaceReturnSo
 func (d MyInterfaceMockDescriptor) newMyInterfaceReturnSomethingAtLeastMockDescriptor() *MyInterfaceReturnSomethingAtLeastMockDescriptor {
 	_, file, line, _ := runtime.Caller(2)
 	return &MyInterfaceReturnSomethingAtLeastMockDescriptor{
-		mockDesc: d,
-		times: func(int) error { return nil },
+		mockDesc:     d,
+		times:        func(int) error { return nil },
 		argValidator: func() []string { return nil },
-		fileLine: fmt.Sprintf("%s:%d", file, line),
+		fileLine:     fmt.Sprintf("%s:%d", file, line),
 	}
 }
 
 // MyInterfaceReturnSomethingAtLeastMockDescriptor is returned by MyInterfaceMockDescriptor.ReturnSomethingAtLeast and
 // holds methods to describe the mock for method MyInterface.ReturnSomethingAtLeast.
 type MyInterfaceReturnSomethingAtLeastMockDescriptor struct {
-	mockDesc MyInterfaceMockDescriptor
-	times func(int) error
+	mockDesc     MyInterfaceMockDescriptor
+	times        func(int) error
 	argValidator func() []string
-	call func() (r0 int)
-	fileLine string
+	call         func() (r0 int)
+	fileLine     string
 }
-	
+
 // Returns lets you specify the values that the mocked method MyInterface.ReturnSomethingAtLeast,
 // if called with values matching the expectations, will return.
-func (d *MyInterfaceReturnSomethingAtLeastMockDescriptor) Returns(r0 int) MyInterfaceReturnSomethingAtLeastMockDescriptorWithReturn {
+func (d *MyInterfaceReturnSomethingAtLeastMockDescriptor) Returns(r0 int) MyInterfaceMockDescribedCall {
 	return d.ReturnsFrom(func() int {
 		return r0
 	})
 }
 
-// Returns lets you specify the values that the mocked method MyInterface.ReturnSomethingAtLeast,
+// ReturnsFrom lets you specify the values that the mocked method MyInterface.ReturnSomethingAtLeast,
 // if called with values matching the expectations, will return.
-// 
-// It passes such passed values to a function that then returns the return values. 
-func (d *MyInterfaceReturnSomethingAtLeastMockDescriptor) ReturnsFrom(f func() (r0 int)) MyInterfaceReturnSomethingAtLeastMockDescriptorWithReturn {
+//
+// It passes such passed values to a function that then returns the return values.
+func (d *MyInterfaceReturnSomethingAtLeastMockDescriptor) ReturnsFrom(f func() (r0 int)) MyInterfaceMockDescribedCall {
 	d.call = f
-	return MyInterfaceReturnSomethingAtLeastMockDescriptorWithReturn{d}
-}
-
-// MyInterfaceReturnSomethingAtLeastMockDescriptorWithReturn is a step forward in the description of a way that
-// method MyInterface.ReturnSomethingAtLeast is to behave when called, with all expected parameters
-// and the resulting values specified.
-// arguments specified.
-// 
-// It has methods to describe the times the method is expected to be called,
-// or you can start another method call description, or you can call Mock to
-// end the description and get the resulting mock.
-type MyInterfaceReturnSomethingAtLeastMockDescriptorWithReturn struct {
-	methodDesc *MyInterfaceReturnSomethingAtLeastMockDescriptor
-}
-	
-// Times lets you specify a exact number of times this method is expected to be
-// called.
-func (d MyInterfaceReturnSomethingAtLeastMockDescriptorWithReturn) Times(times int) MyInterfaceMockDescriptor {
-	return d.TimesMatching(func(got int) error {
-		if got != times {
-			return fmt.Errorf("expected exactly %d calls, got %d", times, got)
-		}
-		return nil
-	})
-}
-
-// AtLeastTimes lets you specify a minimum number of times this method is expected to be
-// called.
-func (d MyInterfaceReturnSomethingAtLeastMockDescriptorWithReturn) AtLeastTimes(times int) MyInterfaceMockDescriptor {
-	return d.TimesMatching(func(got int) error {
-		if got < times {
-			return fmt.Errorf("expected at least %d calls, got %d", times, got)
-		}
-		return nil
-	})
-}
-
-// TimesMatching lets you pass a function to accept or reject the number of times
-// this method has been called.
-func (d MyInterfaceReturnSomethingAtLeastMockDescriptorWithReturn) TimesMatching(f func(times int) error) MyInterfaceMockDescriptor {
-	d.methodDesc.times = f
-	d.methodDesc.done()
-	return d.methodDesc.mockDesc
+	return d.done()
 }
 
-// Mock finishes the description and produces a mock.
-//
-// See MyInterfaceMockDescriptor.Mock for details.
-func (d MyInterfaceReturnSomethingAtLeastMockDescriptorWithReturn) Mock() (m MyInterfaceMock, assert func(t interface{ Errorf(string, ...interface{})  }) (ok bool)) {
-	d.methodDesc.done()
-	return d.methodDesc.mockDesc.Mock()
-}
-	
-// Boring finishes the current description for method MyInterface.ReturnSomethingAtLeast and
-// starts describing for method Boring.
-//
-// See MyInterfaceMockDescriptor.Boring for details.
-func (d MyInterfaceReturnSomethingAtLeastMockDescriptorWithReturn) Boring() *MyInterfaceBoringMockDescriptor {
-	d.methodDesc.done()
-	return d.methodDesc.mockDesc.newMyInterfaceBoringMockDescriptor()
-}
-	
-// EmbeddedMethod finishes the current description for method MyInterface.ReturnSomethingAtLeast and
-// starts describing for method EmbeddedMethod.
-//
-// See MyInterfaceMockDescriptor.EmbeddedMethod for details.
-func (d MyInterfaceReturnSomethingAtLeastMockDescriptorWithReturn) EmbeddedMethod() *MyInterfaceEmbeddedMethodMockDescriptor {
-	d.methodDesc.done()
-	return d.methodDesc.mockDesc.newMyInterfaceEmbeddedMethodMockDescriptor()
-}
-	
-// ReturnSomethingAtLeast finishes the current description for method MyInterface.ReturnSomethingAtLeast and
-// starts describing for method ReturnSomethingAtLeast.
-//
-// See MyInterfaceMockDescriptor.ReturnSomethingAtLeast for details.
-func (d MyInterfaceReturnSomethingAtLeastMockDescriptorWithReturn) ReturnSomethingAtLeast() *MyInterfaceReturnSomethingAtLeastMockDescriptor {
-	d.methodDesc.done()
-	return d.methodDesc.mockDesc.newMyInterfaceReturnSomethingAtLeastMockDescriptor()
-}
-	
-// ShouldBeFun finishes the current description for method MyInterface.ReturnSomethingAtLeast and
-// starts describing for method ShouldBeFun.
-//
-// See MyInterfaceMockDescriptor.ShouldBeFun for details.
-func (d MyInterfaceReturnSomethingAtLeastMockDescriptorWithReturn) ShouldBeFun() *MyInterfaceShouldBeFunMockDescriptor {
-	d.methodDesc.done()
-	return d.methodDesc.mockDesc.newMyInterfaceShouldBeFunMockDescriptor()
-}
-	
-// StdSomething finishes the current description for method MyInterface.ReturnSomethingAtLeast and
-// starts describing for method StdSomething.
-//
-// See MyInterfaceMockDescriptor.StdSomething for details.
-func (d MyInterfaceReturnSomethingAtLeastMockDescriptorWithReturn) StdSomething() *MyInterfaceStdSomethingMockDescriptor {
-	d.methodDesc.done()
-	return d.methodDesc.mockDesc.newMyInterfaceStdSomethingMockDescriptor()
-}
-	
-func (d *MyInterfaceReturnSomethingAtLeastMockDescriptor) done() {
+// done ends the description, adding it to the mock descriptor.
+func (d *MyInterfaceReturnSomethingAtLeastMockDescriptor) done() MyInterfaceMockDescribedCall {
 	d.mockDesc.descriptors_ReturnSomethingAtLeast = append(d.mockDesc.descriptors_ReturnSomethingAtLeast, d)
+	return MyInterfaceMockDescribedCall{d.mockDesc, &d.times}
 }
-	
+
 // ShouldBeFun starts describing a way method MyInterface.ShouldBeFun is expected to be called
 // and what it should return.
 //
 // You can call it several times to describe different behaviors, each matching different parameters.
+//
+// MyInterface.ShouldBeFun is documented as follows.
+//
+// ShouldBeFun takes some complex types, and a variadic argument. Its
+// parameters are unnamed, so they get synthetic names in the mock.
 func (d MyInterfaceMockDescriptor) ShouldBeFun() *MyInterfaceShouldBeFunMockDescriptor {
 	return d.newMyInterfaceShouldBeFunMockDescriptor()
 }
@@ -761,8 +573,10 @@ func (d MyInterfaceMockDescriptor) newMyInterfaceShouldBeFunMockDescriptor() *My
 	_, file, line, _ := runtime.Caller(2)
 	return &MyInterfaceShouldBeFunMockDescriptor{
 		mockDesc: d,
-		times: func(int) error { return nil },
-		argValidator: func(got_a0 int, got_a1 map[string]map[examples.MyStruct]bool, got_a2 []chan<- <-chan struct{}) []string { return nil },
+		times:    func(int) error { return nil },
+		argValidator: func(got_a0 int, got_a1 map[string]map[examples.MyStruct]bool, got_a2 []chan<- <-chan struct{}) []string {
+			return nil
+		},
 		fileLine: fmt.Sprintf("%s:%d", file, line),
 	}
 }
@@ -770,16 +584,16 @@ func (d MyInterfaceMockDescriptor) newMyInterfaceShouldBeFunMockDescriptor() *My
 // MyInterfaceShouldBeFunMockDescriptor is returned by MyInterfaceMockDescriptor.ShouldBeFun and
 // holds methods to describe the mock for method MyInterface.ShouldBeFun.
 type MyInterfaceShouldBeFunMockDescriptor struct {
-	mockDesc MyInterfaceMockDescriptor
-	times func(int) error
+	mockDesc     MyInterfaceMockDescriptor
+	times        func(int) error
 	argValidator func(got_a0 int, got_a1 map[string]map[examples.MyStruct]bool, got_a2 []chan<- <-chan struct{}) []string
-	call func(a0 int, a1 map[string]map[examples.MyStruct]bool, a2 []chan<- <-chan struct{}) (r0 int, r1 error)
-	fileLine string
+	call         func(a0 int, a1 map[string]map[examples.MyStruct]bool, a2 []chan<- <-chan struct{}) (r0 int, r1 error)
+	fileLine     string
 }
-	
+
 // Takes lets you specify a value with which the actual value passed to
 // the mocked method MyInterface.ShouldBeFun as parameter #1
-// will be compared. 
+// will be compared.
 //
 // Package "github.com/google/go-cmp/cmp" is used to do the comparison. You can
 // pass extra options for it.
@@ -792,7 +606,7 @@ func (d *MyInterfaceShouldBeFunMockDescriptor) Takes(a0 int, opts ...cmp.Option)
 	d.argValidator = func(got_a0 int, got_a1 map[string]map[examples.MyStruct]bool, got_a2 []chan<- <-chan struct{}) []string {
 		errMsgs := prev(got_a0, got_a1, got_a2)
 		if diff := cmp.Diff(a0, got_a0, opts...); diff != "" {
-			errMsgs = append(errMsgs, "parameter #1 mismatch:\n" + diff)
+			errMsgs = append(errMsgs, "parameter #1 mismatch:\n"+diff)
 		}
 		return errMsgs
 	}
@@ -812,7 +626,7 @@ func (d *MyInterfaceShouldBeFunMockDescriptor) TakesMatching(match func(a0 int)
 	d.argValidator = func(got_a0 int, got_a1 map[string]map[examples.MyStruct]bool, got_a2 []chan<- <-chan struct{}) []string {
 		errMsgs := prev(got_a0, got_a1, got_a2)
 		if err := match(got_a0); err != nil {
-			errMsgs = append(errMsgs, "parameter \"a0\" custom matcher error: " + err.Error())
+			errMsgs = append(errMsgs, "parameter \"a0\" custom matcher error: "+err.Error())
 		}
 		return errMsgs
 	}
@@ -823,16 +637,15 @@ func (d *MyInterfaceShouldBeFunMockDescriptor) TakesMatching(match func(a0 int)
 // method MyInterface.ShouldBeFun is expected to be called, with 1
 // arguments specified.
 //
-// It has methods to describe the next argument, if there's
-// any left, or the return values, if there are any, or the times it's expected
-// to be called otherwise.
+// It has methods to describe the next argument, if there's any left, or the
+// return values otherwise.
 type MyInterfaceShouldBeFunMockDescriptorWith1Arg struct {
 	methodDesc *MyInterfaceShouldBeFunMockDescriptor
 }
-	
+
 // And lets you specify a value with which the actual value passed to
 // the mocked method MyInterface.ShouldBeFun as parameter #2
-// will be compared. 
+// will be compared.
 //
 // Package "github.com/google/go-cmp/cmp" is used to do the comparison. You can
 // pass extra options for it.
@@ -845,7 +658,7 @@ func (d MyInterfaceShouldBeFunMockDescriptorWith1Arg) And(a1 map[string]map[exam
 	d.methodDesc.argValidator = func(got_a0 int, got_a1 map[string]map[examples.MyStruct]bool, got_a2 []chan<- <-chan struct{}) []string {
 		errMsgs := prev(got_a0, got_a1, got_a2)
 		if diff := cmp.Diff(a1, got_a1, opts...); diff != "" {
-			errMsgs = append(errMsgs, "parameter #2 mismatch:\n" + diff)
+			errMsgs = append(errMsgs, "parameter #2 mismatch:\n"+diff)
 		}
 		return errMsgs
 	}
@@ -865,7 +678,7 @@ func (d MyInterfaceShouldBeFunMockDescriptorWith1Arg) AndMatching(match func(a1
 	d.methodDesc.argValidator = func(got_a0 int, got_a1 map[string]map[examples.MyStruct]bool, got_a2 []chan<- <-chan struct{}) []string {
 		errMsgs := prev(got_a0, got_a1, got_a2)
 		if err := match(got_a1); err != nil {
-			errMsgs = append(errMsgs, "parameter \"a1\" custom matcher error: " + err.Error())
+			errMsgs = append(errMsgs, "parameter \"a1\" custom matcher error: "+err.Error())
 		}
 		return errMsgs
 	}
@@ -876,16 +689,15 @@ func (d MyInterfaceShouldBeFunMockDescriptorWith1Arg) AndMatching(match func(a1
 // method MyInterface.ShouldBeFun is expected to be called, with 2
 // arguments specified.
 //
-// It has methods to describe the next argument, if there's
-// any left, or the return values, if there are any, or the times it's expected
-// to be called otherwise.
+// It has methods to describe the next argument, if there's any left, or the
+// return values otherwise.
 type MyInterfaceShouldBeFunMockDescriptorWith2Args struct {
 	methodDesc *MyInterfaceShouldBeFunMockDescriptor
 }
-	
+
 // And lets you specify a value with which the actual value passed to
 // the mocked method MyInterface.ShouldBeFun as parameter #3
-// will be compared. 
+// will be compared.
 //
 // Package "github.com/google/go-cmp/cmp" is used to do the comparison. You can
 // pass extra options for it.
@@ -898,7 +710,7 @@ func (d MyInterfaceShouldBeFunMockDescriptorWith2Args) And(a2 []chan<- <-chan st
 	d.methodDesc.argValidator = func(got_a0 int, got_a1 map[string]map[examples.MyStruct]bool, got_a2 []chan<- <-chan struct{}) []string {
 		errMsgs := prev(got_a0, got_a1, got_a2)
 		if diff := cmp.Diff(a2, got_a2, opts...); diff != "" {
-			errMsgs = append(errMsgs, "parameter #3 mismatch:\n" + diff)
+			errMsgs = append(errMsgs, "parameter #3 mismatch:\n"+diff)
 		}
 		return errMsgs
 	}
@@ -918,7 +730,7 @@ func (d MyInterfaceShouldBeFunMockDescriptorWith2Args) AndMatching(match func(a2
 	d.methodDesc.argValidator = func(got_a0 int, got_a1 map[string]map[examples.MyStruct]bool, got_a2 []chan<- <-chan struct{}) []string {
 		errMsgs := prev(got_a0, got_a1, got_a2)
 		if err := match(got_a2); err != nil {
-			errMsgs = append(errMsgs, "parameter \"a2\" custom matcher error: " + err.Error())
+			errMsgs = append(errMsgs, "parameter \"a2\" custom matcher error: "+err.Error())
 		}
 		return errMsgs
 	}
@@ -929,133 +741,43 @@ func (d MyInterfaceShouldBeFunMockDescriptorWith2Args) AndMatching(match func(a2
 // method MyInterface.ShouldBeFun is expected to be called, with 3
 // arguments specified.
 //
-// It has methods to describe the next argument, if there's
-// any left, or the return values, if there are any, or the times it's expected
-// to be called otherwise.
+// It has methods to describe the next argument, if there's any left, or the
+// return values otherwise.
 type MyInterfaceShouldBeFunMockDescriptorWith3Args struct {
 	methodDesc *MyInterfaceShouldBeFunMockDescriptor
 }
-	
+
 // Returns lets you specify the values that the mocked method MyInterface.ShouldBeFun,
 // if called with values matching the expectations, will return.
-func (d MyInterfaceShouldBeFunMockDescriptorWith3Args) Returns(r0 int, r1 error) MyInterfaceShouldBeFunMockDescriptorWithReturn {
+func (d MyInterfaceShouldBeFunMockDescriptorWith3Args) Returns(r0 int, r1 error) MyInterfaceMockDescribedCall {
 	return d.ReturnsFrom(func(int, map[string]map[examples.MyStruct]bool, []chan<- <-chan struct{}) (int, error) {
 		return r0, r1
 	})
 }
 
-// Returns lets you specify the values that the mocked method MyInterface.ShouldBeFun,
+// ReturnsFrom lets you specify the values that the mocked method MyInterface.ShouldBeFun,
 // if called with values matching the expectations, will return.
-// 
-// It passes such passed values to a function that then returns the return values. 
-func (d MyInterfaceShouldBeFunMockDescriptorWith3Args) ReturnsFrom(f func(a0 int, a1 map[string]map[examples.MyStruct]bool, a2 []chan<- <-chan struct{}) (r0 int, r1 error)) MyInterfaceShouldBeFunMockDescriptorWithReturn {
+//
+// It passes such passed values to a function that then returns the return values.
+func (d MyInterfaceShouldBeFunMockDescriptorWith3Args) ReturnsFrom(f func(a0 int, a1 map[string]map[examples.MyStruct]bool, a2 []chan<- <-chan struct{}) (r0 int, r1 error)) MyInterfaceMockDescribedCall {
 	d.methodDesc.call = f
-	return MyInterfaceShouldBeFunMockDescriptorWithReturn{d.methodDesc}
+	return d.methodDesc.done()
 }
 
-// MyInterfaceShouldBeFunMockDescriptorWithReturn is a step forward in the description of a way that
-// method MyInterface.ShouldBeFun is to behave when called, with all expected parameters
-// and the resulting values specified.
-// arguments specified.
-// 
-// It has methods to describe the times the method is expected to be called,
-// or you can start another method call description, or you can call Mock to
-// end the description and get the resulting mock.
-type MyInterfaceShouldBeFunMockDescriptorWithReturn struct {
-	methodDesc *MyInterfaceShouldBeFunMockDescriptor
-}
-	
-// Times lets you specify a exact number of times this method is expected to be
-// called.
-func (d MyInterfaceShouldBeFunMockDescriptorWithReturn) Times(times int) MyInterfaceMockDescriptor {
-	return d.TimesMatching(func(got int) error {
-		if got != times {
-			return fmt.Errorf("expected exactly %d calls, got %d", times, got)
-		}
-		return nil
-	})
-}
-
-// AtLeastTimes lets you specify a minimum number of times this method is expected to be
-// called.
-func (d MyInterfaceShouldBeFunMockDescriptorWithReturn) AtLeastTimes(times int) MyInterfaceMockDescriptor {
-	return d.TimesMatching(func(got int) error {
-		if got < times {
-			return fmt.Errorf("expected at least %d calls, got %d", times, got)
-		}
-		return nil
-	})
-}
-
-// TimesMatching lets you pass a function to accept or reject the number of times
-// this method has been called.
-func (d MyInterfaceShouldBeFunMockDescriptorWithReturn) TimesMatching(f func(times int) error) MyInterfaceMockDescriptor {
-	d.methodDesc.times = f
-	d.methodDesc.done()
-	return d.methodDesc.mockDesc
-}
-
-// Mock finishes the description and produces a mock.
-//
-// See MyInterfaceMockDescriptor.Mock for details.
-func (d MyInterfaceShouldBeFunMockDescriptorWithReturn) Mock() (m MyInterfaceMock, assert func(t interface{ Errorf(string, ...interface{})  }) (ok bool)) {
-	d.methodDesc.done()
-	return d.methodDesc.mockDesc.Mock()
-}
-	
-// Boring finishes the current description for method MyInterface.ShouldBeFun and
-// starts describing for method Boring.
-//
-// See MyInterfaceMockDescriptor.Boring for details.
-func (d MyInterfaceShouldBeFunMockDescriptorWithReturn) Boring() *MyInterfaceBoringMockDescriptor {
-	d.methodDesc.done()
-	return d.methodDesc.mockDesc.newMyInterfaceBoringMockDescriptor()
-}
-	
-// EmbeddedMethod finishes the current description for method MyInterface.ShouldBeFun and
-// starts describing for method EmbeddedMethod.
-//
-// See MyInterfaceMockDescriptor.EmbeddedMethod for details.
-func (d MyInterfaceShouldBeFunMockDescriptorWithReturn) EmbeddedMethod() *MyInterfaceEmbeddedMethodMockDescriptor {
-	d.methodDesc.done()
-	return d.methodDesc.mockDesc.newMyInterfaceEmbeddedMethodMockDescriptor()
-}
-	
-// ReturnSomethingAtLeast finishes the current description for method MyInterface.ShouldBeFun and
-// starts describing for method ReturnSomethingAtLeast.
-//
-// See MyInterfaceMockDescriptor.ReturnSomethingAtLeast for details.
-func (d MyInterfaceShouldBeFunMockDescriptorWithReturn) ReturnSomethingAtLeast() *MyInterfaceReturnSomethingAtLeastMockDescriptor {
-	d.methodDesc.done()
-	return d.methodDesc.mockDesc.newMyInterfaceReturnSomethingAtLeastMockDescriptor()
-}
-	
-// ShouldBeFun finishes the current description for method MyInterface.ShouldBeFun and
-// starts describing for method ShouldBeFun.
-//
-// See MyInterfaceMockDescriptor.ShouldBeFun for details.
-func (d MyInterfaceShouldBeFunMockDescriptorWithReturn) ShouldBeFun() *MyInterfaceShouldBeFunMockDescriptor {
-	d.methodDesc.done()
-	return d.methodDesc.mockDesc.newMyInterfaceShouldBeFunMockDescriptor()
-}
-	
-// StdSomething finishes the current description for method MyInterface.ShouldBeFun and
-// starts describing for method StdSomething.
-//
-// See MyInterfaceMockDescriptor.StdSomething for details.
-func (d MyInterfaceShouldBeFunMockDescriptorWithReturn) StdSomething() *MyInterfaceStdSomethingMockDescriptor {
-	d.methodDesc.done()
-	return d.methodDesc.mockDesc.newMyInterfaceStdSomethingMockDescriptor()
-}
-	
-func (d *MyInterfaceShouldBeFunMockDescriptor) done() {
+// done ends the description, adding it to the mock descriptor.
+func (d *MyInterfaceShouldBeFunMockDescriptor) done() MyInterfaceMockDescribedCall {
 	d.mockDesc.descriptors_ShouldBeFun = append(d.mockDesc.descriptors_ShouldBeFun, d)
+	return MyInterfaceMockDescribedCall{d.mockDesc, &d.times}
 }
-	
+
 // StdSomething starts describing a way method MyInterface.StdSomething is expected to be called
 // and what it should return.
 //
 // You can call it several times to describe different behaviors, each matching different parameters.
+//
+// MyInterface.StdSomething is documented as follows.
+//
+// StdSomething takes a type from the standard library.
 func (d MyInterfaceMockDescriptor) StdSomething() *MyInterfaceStdSomethingMockDescriptor {
 	return d.newMyInterfaceStdSomethingMockDescriptor()
 }
@@ -1063,26 +785,26 @@ func (d MyInterfaceMockDescriptor) StdSomething() *MyInterfaceStdSomethingMockDe
 func (d MyInterfaceMockDescriptor) newMyInterfaceStdSomethingMockDescriptor() *MyInterfaceStdSomethingMockDescriptor {
 	_, file, line, _ := runtime.Caller(2)
 	return &MyInterfaceStdSomethingMockDescriptor{
-		mockDesc: d,
-		times: func(int) error { return nil },
+		mockDesc:     d,
+		times:        func(int) error { return nil },
 		argValidator: func(got_f *os.File, got_ints []int) []string { return nil },
-		fileLine: fmt.Sprintf("%s:%d", file, line),
+		fileLine:     fmt.Sprintf("%s:%d", file, line),
 	}
 }
 
 // MyInterfaceStdSomethingMockDescriptor is returned by MyInterfaceMockDescriptor.StdSomething and
 // holds methods to describe the mock for method MyInterface.StdSomething.
 type MyInterfaceStdSomethingMockDescriptor struct {
-	mockDesc MyInterfaceMockDescriptor
-	times func(int) error
+	mockDesc     MyInterfaceMockDescriptor
+	times        func(int) error
 	argValidator func(got_f *os.File, got_ints []int) []string
-	call func(f *os.File, ints []int) (named bool)
-	fileLine string
+	call         func(f *os.File, ints []int) (named bool)
+	fileLine     string
 }
-	
+
 // Takes lets you specify a value with which the actual value passed to
 // the mocked method MyInterface.StdSomething as parameter #1
-// will be compared. 
+// will be compared.
 //
 // Package "github.com/google/go-cmp/cmp" is used to do the comparison. You can
 // pass extra options for it.
@@ -1095,7 +817,7 @@ func (d *MyInterfaceStdSomethingMockDescriptor) Takes(f *os.File, opts ...cmp.Op
 	d.argValidator = func(got_f *os.File, got_ints []int) []string {
 		errMsgs := prev(got_f, got_ints)
 		if diff := cmp.Diff(f, got_f, opts...); diff != "" {
-			errMsgs = append(errMsgs, "parameter #1 mismatch:\n" + diff)
+			errMsgs = append(errMsgs, "parameter #1 mismatch:\n"+diff)
 		}
 		return errMsgs
 	}
@@ -1115,7 +837,7 @@ func (d *MyInterfaceStdSomethingMockDescriptor) TakesMatching(match func(f *os.F
 	d.argValidator = func(got_f *os.File, got_ints []int) []string {
 		errMsgs := prev(got_f, got_ints)
 		if err := match(got_f); err != nil {
-			errMsgs = append(errMsgs, "parameter \"f\" custom matcher error: " + err.Error())
+			errMsgs = append(errMsgs, "parameter \"f\" custom matcher error: "+err.Error())
 		}
 		return errMsgs
 	}
@@ -1126,16 +848,15 @@ func (d *MyInterfaceStdSomethingMockDescriptor) TakesMatching(match func(f *os.F
 // method MyInterface.StdSomething is expected to be called, with 1
 // arguments specified.
 //
-// It has methods to describe the next argument, if there's
-// any left, or the return values, if there are any, or the times it's expected
-// to be called otherwise.
+// It has methods to describe the next argument, if there's any left, or the
+// return values otherwise.
 type MyInterfaceStdSomethingMockDescriptorWith1Arg struct {
 	methodDesc *MyInterfaceStdSomethingMockDescriptor
 }
-	
+
 // And lets you specify a value with which the actual value passed to
 // the mocked method MyInterface.StdSomething as parameter #2
-// will be compared. 
+// will be compared.
 //
 // Package "github.com/google/go-cmp/cmp" is used to do the comparison. You can
 // pass extra options for it.
@@ -1148,7 +869,7 @@ func (d MyInterfaceStdSomethingMockDescriptorWith1Arg) And(ints []int, opts ...c
 	d.methodDesc.argValidator = func(got_f *os.File, got_ints []int) []string {
 		errMsgs := prev(got_f, got_ints)
 		if diff := cmp.Diff(ints, got_ints, opts...); diff != "" {
-			errMsgs = append(errMsgs, "parameter #2 mismatch:\n" + diff)
+			errMsgs = append(errMsgs, "parameter #2 mismatch:\n"+diff)
 		}
 		return errMsgs
 	}
@@ -1168,7 +889,7 @@ func (d MyInterfaceStdSomethingMockDescriptorWith1Arg) AndMatching(match func(in
 	d.methodDesc.argValidator = func(got_f *os.File, got_ints []int) []string {
 		errMsgs := prev(got_f, got_ints)
 		if err := match(got_ints); err != nil {
-			errMsgs = append(errMsgs, "parameter \"ints\" custom matcher error: " + err.Error())
+			errMsgs = append(errMsgs, "parameter \"ints\" custom matcher error: "+err.Error())
 		}
 		return errMsgs
 	}
@@ -1179,129 +900,35 @@ func (d MyInterfaceStdSomethingMockDescriptorWith1Arg) AndMatching(match func(in
 // method MyInterface.StdSomething is expected to be called, with 2
 // arguments specified.
 //
-// It has methods to describe the next argument, if there's
-// any left, or the return values, if there are any, or the times it's expected
-// to be called otherwise.
+// It has methods to describe the next argument, if there's any left, or the
+// return values otherwise.
 type MyInterfaceStdSomethingMockDescriptorWith2Args struct {
 	methodDesc *MyInterfaceStdSomethingMockDescriptor
 }
-	
+
 // Returns lets you specify the values that the mocked method MyInterface.StdSomething,
 // if called with values matching the expectations, will return.
-func (d MyInterfaceStdSomethingMockDescriptorWith2Args) Returns(named bool) MyInterfaceStdSomethingMockDescriptorWithReturn {
+func (d MyInterfaceStdSomethingMockDescriptorWith2Args) Returns(named bool) MyInterfaceMockDescribedCall {
 	return d.ReturnsFrom(func(*os.File, []int) bool {
 		return named
 	})
 }
 
-// Returns lets you specify the values that the mocked method MyInterface.StdSomething,
+// ReturnsFrom lets you specify the values that the mocked method MyInterface.StdSomething,
 // if called with values matching the expectations, will return.
-// 
-// It passes such passed values to a function that then returns the return values. 
-func (d MyInterfaceStdSomethingMockDescriptorWith2Args) ReturnsFrom(f func(f *os.File, ints []int) (named bool)) MyInterfaceStdSomethingMockDescriptorWithReturn {
+//
+// It passes such passed values to a function that then returns the return values.
+func (d MyInterfaceStdSomethingMockDescriptorWith2Args) ReturnsFrom(f func(f *os.File, ints []int) (named bool)) MyInterfaceMockDescribedCall {
 	d.methodDesc.call = f
-	return MyInterfaceStdSomethingMockDescriptorWithReturn{d.methodDesc}
+	return d.methodDesc.done()
 }
 
-// MyInterfaceStdSomethingMockDescriptorWithReturn is a step forward in the description of a way that
-// method MyInterface.StdSomething is to behave when called, with all expected parameters
-// and the resulting values specified.
-// arguments specified.
-// 
-// It has methods to describe the times the method is expected to be called,
-// or you can start another method call description, or you can call Mock to
-// end the description and get the resulting mock.
-type MyInterfaceStdSomethingMockDescriptorWithReturn struct {
-	methodDesc *MyInterfaceStdSomethingMockDescriptor
-}
-	
-// Times lets you specify a exact number of times this method is expected to be
-// called.
-func (d MyInterfaceStdSomethingMockDescriptorWithReturn) Times(times int) MyInterfaceMockDescriptor {
-	return d.TimesMatching(func(got int) error {
-		if got != times {
-			return fmt.Errorf("expected exactly %d calls, got %d", times, got)
-		}
-		return nil
-	})
-}
-
-// AtLeastTimes lets you specify a minimum number of times this method is expected to be
-// called.
-func (d MyInterfaceStdSomethingMockDescriptorWithReturn) AtLeastTimes(times int) MyInterfaceMockDescriptor {
-	return d.TimesMatching(func(got int) error {
-		if got < times {
-			return fmt.Errorf("expected at least %d calls, got %d", times, got)
-		}
-		return nil
-	})
-}
-
-// TimesMatching lets you pass a function to accept or reject the number of times
-// this method has been called.
-func (d MyInterfaceStdSomethingMockDescriptorWithReturn) TimesMatching(f func(times int) error) MyInterfaceMockDescriptor {
-	d.methodDesc.times = f
-	d.methodDesc.done()
-	return d.methodDesc.mockDesc
-}
-
-// Mock finishes the description and produces a mock.
-//
-// See MyInterfaceMockDescriptor.Mock for details.
-func (d MyInterfaceStdSomethingMockDescriptorWithReturn) Mock() (m MyInterfaceMock, assert func(t interface{ Errorf(string, ...interface{})  }) (ok bool)) {
-	d.methodDesc.done()
-	return d.methodDesc.mockDesc.Mock()
-}
-	
-// Boring finishes the current description for method MyInterface.StdSomething and
-// starts describing for method Boring.
-//
-// See MyInterfaceMockDescriptor.Boring for details.
-func (d MyInterfaceStdSomethingMockDescriptorWithReturn) Boring() *MyInterfaceBoringMockDescriptor {
-	d.methodDesc.done()
-	return d.methodDesc.mockDesc.newMyInterfaceBoringMockDescriptor()
-}
-	
-// EmbeddedMethod finishes the current description for method MyInterface.StdSomething and
-// starts describing for method EmbeddedMethod.
-//
-// See MyInterfaceMockDescriptor.EmbeddedMethod for details.
-func (d MyInterfaceStdSomethingMockDescriptorWithReturn) EmbeddedMethod() *MyInterfaceEmbeddedMethodMockDescriptor {
-	d.methodDesc.done()
-	return d.methodDesc.mockDesc.newMyInterfaceEmbeddedMethodMockDescriptor()
-}
-	
-// ReturnSomethingAtLeast finishes the current description for method MyInterface.StdSomething and
-// starts describing for method ReturnSomethingAtLeast.
-//
-// See MyInterfaceMockDescriptor.ReturnSomethingAtLeast for details.
-func (d MyInterfaceStdSomethingMockDescriptorWithReturn) ReturnSomethingAtLeast() *MyInterfaceReturnSomethingAtLeastMockDescriptor {
-	d.methodDesc.done()
-	return d.methodDesc.mockDesc.newMyInterfaceReturnSomethingAtLeastMockDescriptor()
-}
-	
-// ShouldBeFun finishes the current description for method MyInterface.StdSomething and
-// starts describing for method ShouldBeFun.
-//
-// See MyInterfaceMockDescriptor.ShouldBeFun for details.
-func (d MyInterfaceStdSomethingMockDescriptorWithReturn) ShouldBeFun() *MyInterfaceShouldBeFunMockDescriptor {
-	d.methodDesc.done()
-	return d.methodDesc.mockDesc.newMyInterfaceShouldBeFunMockDescriptor()
-}
-	
-// StdSomething finishes the current description for method MyInterface.StdSomething and
-// starts describing for method StdSomething.
-//
-// See MyInterfaceMockDescriptor.StdSomething for details.
-func (d MyInterfaceStdSomethingMockDescriptorWithReturn) StdSomething() *MyInterfaceStdSomethingMockDescriptor {
-	d.methodDesc.done()
-	return d.methodDesc.mockDesc.newMyInterfaceStdSomethingMockDescriptor()
-}
-	
-func (d *MyInterfaceStdSomethingMockDescriptor) done() {
+// done ends the description, adding it to the mock descriptor.
+func (d *MyInterfaceStdSomethingMockDescriptor) done() MyInterfaceMockDescribedCall {
 	d.mockDesc.descriptors_StdSomething = append(d.mockDesc.descriptors_StdSomething, d)
+	return MyInterfaceMockDescribedCall{d.mockDesc, &d.times}
 }
-	
+
 // Mock returns a mock for MyInterface that calls the functions
 // defined as struct fields in the receiver.
 //
@@ -1339,9 +966,14 @@ func (m _makegomock_MyInterfaceMockFromMocker) StdSomething(f *os.File, ints ...
 // It is copied from the original just to avoid introducing a dependency on
 // MyInterface's package.
 type MyInterfaceMock interface {
+	// Boring takes nothing and returns nothing.
 	Boring()
 	EmbeddedMethod()
+	// ReturnSomethingAtLeast returns an int, which is something at least.
 	ReturnSomethingAtLeast() (r0 int)
+	// ShouldBeFun takes some complex types, and a variadic argument. Its
+	// parameters are unnamed, so they get synthetic names in the mock.
 	ShouldBeFun(a0 int, a1 map[string]map[examples.MyStruct]bool, a2 ...chan<- <-chan struct{}) (r0 int, r1 error)
+	// StdSomething takes a type from the standard library.
 	StdSomething(f *os.File, ints ...int) (named bool)
 }
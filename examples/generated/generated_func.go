@@ -3,10 +3,12 @@
 package generated
 
 import (
-	fmt "fmt"
-	cmp "github.com/google/go-cmp/cmp"
-	examples "github.com/tcard/make.go.mock/examples"
-	runtime "runtime"
+	"fmt"
+	"runtime"
+	"sync"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/tcard/make.go.mock/examples"
 )
 
 // MyFuncMocker builds mocks for type MyFunc.
@@ -38,7 +40,7 @@ func (m *MyFuncMocker) Describe() MyFuncMockDescriptor {
 // When you're done describing methods, call its Mock method to get a mock that
 // implements the behavior you described.
 type MyFuncMockDescriptor struct {
-	m *MyFuncMocker
+	m                *MyFuncMocker
 	descriptors_Func []*MyFuncFuncMockDescriptor
 }
 
@@ -48,18 +50,22 @@ type MyFuncMockDescriptor struct {
 // It also returns a function that should be called before the test is done to
 // ensure that the expected number of calls to the mock methods happened. You
 // can pass a *testing.T to it, since it implements the interface it wants.
-func (d MyFuncMockDescriptor) Mock() (m MyFuncMock, assert func(t interface{ Errorf(s string, args ...interface{})  }) (ok bool)) {
+func (d MyFuncMockDescriptor) Mock() (m MyFuncMock, assert func(t interface {
+	Errorf(s string, args ...interface{})
+}) (ok bool)) {
 	assert = d.done()
 	return d.m.Mock(), assert
 }
 
-func (d MyFuncMockDescriptor) done() func(t interface{ Errorf(s string, args ...interface{})  }) bool {
+func (d MyFuncMockDescriptor) done() func(t interface {
+	Errorf(s string, args ...interface{})
+}) bool {
 	var atAssert []func() (method string, errs []string)
 	type specErrs struct {
 		fileLine string
-		errs []string
+		errs     []string
 	}
-	
+
 	if len(d.descriptors_Func) > 0 {
 		for _, desc := range d.descriptors_Func {
 			desc := desc
@@ -101,7 +107,7 @@ func (d MyFuncMockDescriptor) done() func(t interface{ Errorf(s string, args ...
 			if len(matching) == 0 {
 				matchingErrs := ""
 				for _, errs := range allErrs {
-					matchingErrs += "\n\tcandidate described at "+errs.fileLine+":\n"
+					matchingErrs += "\n\tcandidate described at " + errs.fileLine + ":\n"
 					for _, err := range errs.errs {
 						matchingErrs += "\n\t\t" + err
 					}
@@ -119,7 +125,9 @@ func (d MyFuncMockDescriptor) done() func(t interface{ Errorf(s string, args ...
 			panic("unexpected call to mock for MyFunc.Func")
 		}
 	}
-	return func(t interface{ Errorf(s string, args ...interface{})  }) bool {
+	return func(t interface {
+		Errorf(s string, args ...interface{})
+	}) bool {
 		ok := true
 		for _, assert := range atAssert {
 			method, errs := assert()
@@ -131,7 +139,80 @@ func (d MyFuncMockDescriptor) done() func(t interface{ Errorf(s string, args ...
 		return ok
 	}
 }
-	
+
+// Install sets *target to the mock, as Mock returns it, until the test that t
+// belongs to finishes. Then, it restores the previous value of *target, and
+// asserts that the mock was called as expected. You can pass a *testing.T to
+// it.
+//
+// It's meant for package-level variables that the code under test calls, like
+// var now = time.Now, which would otherwise need to be saved and restored by
+// hand.
+//
+// It panics if a MyFunc mock is already installed into target, as it
+// would happen if tests installing it ran in parallel.
+func (d MyFuncMockDescriptor) Install(target *examples.MyFunc, t interface {
+	Cleanup(func())
+	Errorf(s string, args ...interface{})
+}) {
+	if _, loaded := _makegomock_MyFuncInstalled.LoadOrStore(target, struct{}{}); loaded {
+		panic("a mock for MyFunc is already installed into the target variable; tests installing it can't run in parallel")
+	}
+	m, assert := d.Mock()
+	prev := *target
+	*target = examples.MyFunc(m)
+	t.Cleanup(func() {
+		*target = prev
+		_makegomock_MyFuncInstalled.Delete(target)
+		assert(t)
+	})
+}
+
+// _makegomock_MyFuncInstalled holds the variables that a MyFunc mock is installed into.
+var _makegomock_MyFuncInstalled sync.Map
+
+// MyFuncMockDescribedCall is the last step in the description of a way that a
+// method of MyFunc is to behave when called, with all expected parameters
+// and the resulting values specified.
+//
+// It has methods to describe the times the method is expected to be called.
+// Otherwise, through the embedded MyFuncMockDescriptor, you can start
+// another method call description, or you can call Mock to end the description
+// and get the resulting mock.
+type MyFuncMockDescribedCall struct {
+	MyFuncMockDescriptor
+	times *func(int) error
+}
+
+// Times lets you specify a exact number of times this method is expected to be
+// called.
+func (d MyFuncMockDescribedCall) Times(times int) MyFuncMockDescriptor {
+	return d.TimesMatching(func(got int) error {
+		if got != times {
+			return fmt.Errorf("expected exactly %d calls, got %d", times, got)
+		}
+		return nil
+	})
+}
+
+// AtLeastTimes lets you specify a minimum number of times this method is expected to be
+// called.
+func (d MyFuncMockDescribedCall) AtLeastTimes(times int) MyFuncMockDescriptor {
+	return d.TimesMatching(func(got int) error {
+		if got < times {
+			return fmt.Errorf("expected at least %d calls, got %d", times, got)
+		}
+		return nil
+	})
+}
+
+// TimesMatching lets you pass a function to accept or reject the number of times
+// this method has been called.
+func (d MyFuncMockDescribedCall) TimesMatching(f func(times int) error) MyFuncMockDescriptor {
+	*d.times = f
+	return d.MyFuncMockDescriptor
+}
+
 // Func starts describing a way method MyFunc.Func is expected to be called
 // and what it should return.
 //
@@ -143,26 +224,26 @@ func (d MyFuncMockDescriptor) Func() *MyFuncFuncMockDescriptor {
 func (d MyFuncMockDescriptor) newMyFuncFuncMockDescriptor() *MyFuncFuncMockDescriptor {
 	_, file, line, _ := runtime.Caller(2)
 	return &MyFuncFuncMockDescriptor{
-		mockDesc: d,
-		times: func(int) error { return nil },
+		mockDesc:     d,
+		times:        func(int) error { return nil },
 		argValidator: func(got_a int, got_b int, got_c int, got_x bool, got_multi []examples.MyStruct) []string { return nil },
-		fileLine: fmt.Sprintf("%s:%d", file, line),
+		fileLine:     fmt.Sprintf("%s:%d", file, line),
 	}
 }
 
 // MyFuncFuncMockDescriptor is returned by MyFuncMockDescriptor.Func and
 // holds methods to describe the mock for method MyFunc.Func.
 type MyFuncFuncMockDescriptor struct {
-	mockDesc MyFuncMockDescriptor
-	times func(int) error
+	mockDesc     MyFuncMockDescriptor
+	times        func(int) error
 	argValidator func(got_a int, got_b int, got_c int, got_x bool, got_multi []examples.MyStruct) []string
-	call func(a int, b int, c int, x bool, multi []examples.MyStruct) (ok bool, err error)
-	fileLine string
+	call         func(a int, b int, c int, x bool, multi []examples.MyStruct) (ok bool, err error)
+	fileLine     string
 }
-	
+
 // Takes lets you specify a value with which the actual value passed to
 // the mocked method MyFunc.Func as parameter #1
-// will be compared. 
+// will be compared.
 //
 // Package "github.com/google/go-cmp/cmp" is used to do the comparison. You can
 // pass extra options for it.
@@ -175,7 +256,7 @@ func (d *MyFuncFuncMockDescriptor) Takes(a int, opts ...cmp.Option) MyFuncFuncMo
 	d.argValidator = func(got_a int, got_b int, got_c int, got_x bool, got_multi []examples.MyStruct) []string {
 		errMsgs := prev(got_a, got_b, got_c, got_x, got_multi)
 		if diff := cmp.Diff(a, got_a, opts...); diff != "" {
-			errMsgs = append(errMsgs, "parameter #1 mismatch:\n" + diff)
+			errMsgs = append(errMsgs, "parameter #1 mismatch:\n"+diff)
 		}
 		return errMsgs
 	}
@@ -195,7 +276,7 @@ func (d *MyFuncFuncMockDescriptor) TakesMatching(match func(a int) error) MyFunc
 	d.argValidator = func(got_a int, got_b int, got_c int, got_x bool, got_multi []examples.MyStruct) []string {
 		errMsgs := prev(got_a, got_b, got_c, got_x, got_multi)
 		if err := match(got_a); err != nil {
-			errMsgs = append(errMsgs, "parameter \"a\" custom matcher error: " + err.Error())
+			errMsgs = append(errMsgs, "parameter \"a\" custom matcher error: "+err.Error())
 		}
 		return errMsgs
 	}
@@ -206,16 +287,15 @@ func (d *MyFuncFuncMockDescriptor) TakesMatching(match func(a int) error) MyFunc
 // method MyFunc.Func is expected to be called, with 1
 // arguments specified.
 //
-// It has methods to describe the next argument, if there's
-// any left, or the return values, if there are any, or the times it's expected
-// to be called otherwise.
+// It has methods to describe the next argument, if there's any left, or the
+// return values otherwise.
 type MyFuncFuncMockDescriptorWith1Arg struct {
 	methodDesc *MyFuncFuncMockDescriptor
 }
-	
+
 // And lets you specify a value with which the actual value passed to
 // the mocked method MyFunc.Func as parameter #2
-// will be compared. 
+// will be compared.
 //
 // Package "github.com/google/go-cmp/cmp" is used to do the comparison. You can
 // pass extra options for it.
@@ -228,7 +308,7 @@ func (d MyFuncFuncMockDescriptorWith1Arg) And(b int, opts ...cmp.Option) MyFuncF
 	d.methodDesc.argValidator = func(got_a int, got_b int, got_c int, got_x bool, got_multi []examples.MyStruct) []string {
 		errMsgs := prev(got_a, got_b, got_c, got_x, got_multi)
 		if diff := cmp.Diff(b, got_b, opts...); diff != "" {
-			errMsgs = append(errMsgs, "parameter #2 mismatch:\n" + diff)
+			errMsgs = append(errMsgs, "parameter #2 mismatch:\n"+diff)
 		}
 		return errMsgs
 	}
@@ -248,7 +328,7 @@ func (d MyFuncFuncMockDescriptorWith1Arg) AndMatching(match func(b int) error) M
 	d.methodDesc.argValidator = func(got_a int, got_b int, got_c int, got_x bool, got_multi []examples.MyStruct) []string {
 		errMsgs := prev(got_a, got_b, got_c, got_x, got_multi)
 		if err := match(got_b); err != nil {
-			errMsgs = append(errMsgs, "parameter \"b\" custom matcher error: " + err.Error())
+			errMsgs = append(errMsgs, "parameter \"b\" custom matcher error: "+err.Error())
 		}
 		return errMsgs
 	}
@@ -259,16 +339,15 @@ func (d MyFuncFuncMockDescriptorWith1Arg) AndMatching(match func(b int) error) M
 // method MyFunc.Func is expected to be called, with 2
 // arguments specified.
 //
-// It has methods to describe the next argument, if there's
-// any left, or the return values, if there are any, or the times it's expected
-// to be called otherwise.
+// It has methods to describe the next argument, if there's any left, or the
+// return values otherwise.
 type MyFuncFuncMockDescriptorWith2Args struct {
 	methodDesc *MyFuncFuncMockDescriptor
 }
-	
+
 // And lets you specify a value with which the actual value passed to
 // the mocked method MyFunc.Func as parameter #3
-// will be compared. 
+// will be compared.
 //
 // Package "github.com/google/go-cmp/cmp" is used to do the comparison. You can
 // pass extra options for it.
@@ -281,7 +360,7 @@ func (d MyFuncFuncMockDescriptorWith2Args) And(c int, opts ...cmp.Option) MyFunc
 	d.methodDesc.argValidator = func(got_a int, got_b int, got_c int, got_x bool, got_multi []examples.MyStruct) []string {
 		errMsgs := prev(got_a, got_b, got_c, got_x, got_multi)
 		if diff := cmp.Diff(c, got_c, opts...); diff != "" {
-			errMsgs = append(errMsgs, "parameter #3 mismatch:\n" + diff)
+			errMsgs = append(errMsgs, "parameter #3 mismatch:\n"+diff)
 		}
 		return errMsgs
 	}
@@ -301,7 +380,7 @@ func (d MyFuncFuncMockDescriptorWith2Args) AndMatching(match func(c int) error)
 	d.methodDesc.argValidator = func(got_a int, got_b int, got_c int, got_x bool, got_multi []examples.MyStruct) []string {
 		errMsgs := prev(got_a, got_b, got_c, got_x, got_multi)
 		if err := match(got_c); err != nil {
-			errMsgs = append(errMsgs, "parameter \"c\" custom matcher error: " + err.Error())
+			errMsgs = append(errMsgs, "parameter \"c\" custom matcher error: "+err.Error())
 		}
 		return errMsgs
 	}
@@ -312,16 +391,15 @@ func (d MyFuncFuncMockDescriptorWith2Args) AndMatching(match func(c int) error)
 // method MyFunc.Func is expected to be called, with 3
 // arguments specified.
 //
-// It has methods to describe the next argument, if there's
-// any left, or the return values, if there are any, or the times it's expected
-// to be called otherwise.
+// It has methods to describe the next argument, if there's any left, or the
+// return values otherwise.
 type MyFuncFuncMockDescriptorWith3Args struct {
 	methodDesc *MyFuncFuncMockDescriptor
 }
-	
+
 // And lets you specify a value with which the actual value passed to
 // the mocked method MyFunc.Func as parameter #4
-// will be compared. 
+// will be compared.
 //
 // Package "github.com/google/go-cmp/cmp" is used to do the comparison. You can
 // pass extra options for it.
@@ -334,7 +412,7 @@ func (d MyFuncFuncMockDescriptorWith3Args) And(x bool, opts ...cmp.Option) MyFun
 	d.methodDesc.argValidator = func(got_a int, got_b int, got_c int, got_x bool, got_multi []examples.MyStruct) []string {
 		errMsgs := prev(got_a, got_b, got_c, got_x, got_multi)
 		if diff := cmp.Diff(x, got_x, opts...); diff != "" {
-			errMsgs = append(errMsgs, "parameter #4 mismatch:\n" + diff)
+			errMsgs = append(errMsgs, "parameter #4 mismatch:\n"+diff)
 		}
 		return errMsgs
 	}
@@ -354,7 +432,7 @@ func (d MyFuncFuncMockDescriptorWith3Args) AndMatching(match func(x bool) error)
 	d.methodDesc.argValidator = func(got_a int, got_b int, got_c int, got_x bool, got_multi []examples.MyStruct) []string {
 		errMsgs := prev(got_a, got_b, got_c, got_x, got_multi)
 		if err := match(got_x); err != nil {
-			errMsgs = append(errMsgs, "parameter \"x\" custom matcher error: " + err.Error())
+			errMsgs = append(errMsgs, "parameter \"x\" custom matcher error: "+err.Error())
 		}
 		return errMsgs
 	}
@@ -365,16 +443,15 @@ func (d MyFuncFuncMockDescriptorWith3Args) AndMatching(match func(x bool) error)
 // method MyFunc.Func is expected to be called, with 4
 // arguments specified.
 //
-// It has methods to describe the next argument, if there's
-// any left, or the return values, if there are any, or the times it's expected
-// to be called otherwise.
+// It has methods to describe the next argument, if there's any left, or the
+// return values otherwise.
 type MyFuncFuncMockDescriptorWith4Args struct {
 	methodDesc *MyFuncFuncMockDescriptor
 }
-	
+
 // And lets you specify a value with which the actual value passed to
 // the mocked method MyFunc.Func as parameter #5
-// will be compared. 
+// will be compared.
 //
 // Package "github.com/google/go-cmp/cmp" is used to do the comparison. You can
 // pass extra options for it.
@@ -387,7 +464,7 @@ func (d MyFuncFuncMockDescriptorWith4Args) And(multi []examples.MyStruct, opts .
 	d.methodDesc.argValidator = func(got_a int, got_b int, got_c int, got_x bool, got_multi []examples.MyStruct) []string {
 		errMsgs := prev(got_a, got_b, got_c, got_x, got_multi)
 		if diff := cmp.Diff(multi, got_multi, opts...); diff != "" {
-			errMsgs = append(errMsgs, "parameter #5 mismatch:\n" + diff)
+			errMsgs = append(errMsgs, "parameter #5 mismatch:\n"+diff)
 		}
 		return errMsgs
 	}
@@ -407,7 +484,7 @@ func (d MyFuncFuncMockDescriptorWith4Args) AndMatching(match func(multi []exampl
 	d.methodDesc.argValidator = func(got_a int, got_b int, got_c int, got_x bool, got_multi []examples.MyStruct) []string {
 		errMsgs := prev(got_a, got_b, got_c, got_x, got_multi)
 		if err := match(got_multi); err != nil {
-			errMsgs = append(errMsgs, "parameter \"multi\" custom matcher error: " + err.Error())
+			errMsgs = append(errMsgs, "parameter \"multi\" custom matcher error: "+err.Error())
 		}
 		return errMsgs
 	}
@@ -418,93 +495,35 @@ func (d MyFuncFuncMockDescriptorWith4Args) AndMatching(match func(multi []exampl
 // method MyFunc.Func is expected to be called, with 5
 // arguments specified.
 //
-// It has methods to describe the next argument, if there's
-// any left, or the return values, if there are any, or the times it's expected
-// to be called otherwise.
+// It has methods to describe the next argument, if there's any left, or the
+// return values otherwise.
 type MyFuncFuncMockDescriptorWith5Args struct {
 	methodDesc *MyFuncFuncMockDescriptor
 }
-	
+
 // Returns lets you specify the values that the mocked method MyFunc.Func,
 // if called with values matching the expectations, will return.
-func (d MyFuncFuncMockDescriptorWith5Args) Returns(ok bool, err error) MyFuncFuncMockDescriptorWithReturn {
+func (d MyFuncFuncMockDescriptorWith5Args) Returns(ok bool, err error) MyFuncMockDescribedCall {
 	return d.ReturnsFrom(func(int, int, int, bool, []examples.MyStruct) (bool, error) {
 		return ok, err
 	})
 }
 
-// Returns lets you specify the values that the mocked method MyFunc.Func,
+// ReturnsFrom lets you specify the values that the mocked method MyFunc.Func,
 // if called with values matching the expectations, will return.
-// 
-// It passes such passed values to a function that then returns the return values. 
-func (d MyFuncFuncMockDescriptorWith5Args) ReturnsFrom(f func(a int, b int, c int, x bool, multi []examples.MyStruct) (ok bool, err error)) MyFuncFuncMockDescriptorWithReturn {
+//
+// It passes such passed values to a function that then returns the return values.
+func (d MyFuncFuncMockDescriptorWith5Args) ReturnsFrom(f func(a int, b int, c int, x bool, multi []examples.MyStruct) (ok bool, err error)) MyFuncMockDescribedCall {
 	d.methodDesc.call = f
-	return MyFuncFuncMockDescriptorWithReturn{d.methodDesc}
-}
-
-// MyFuncFuncMockDescriptorWithReturn is a step forward in the description of a way that
-// method MyFunc.Func is to behave when called, with all expected parameters
-// and the resulting values specified.
-// arguments specified.
-// 
-// It has methods to describe the times the method is expected to be called,
-// or you can start another method call description, or you can call Mock to
-// end the description and get the resulting mock.
-type MyFuncFuncMockDescriptorWithReturn struct {
-	methodDesc *MyFuncFuncMockDescriptor
-}
-	
-// Times lets you specify a exact number of times this method is expected to be
-// called.
-func (d MyFuncFuncMockDescriptorWithReturn) Times(times int) MyFuncMockDescriptor {
-	return d.TimesMatching(func(got int) error {
-		if got != times {
-			return fmt.Errorf("expected exactly %d calls, got %d", times, got)
-		}
-		return nil
-	})
+	return d.methodDesc.done()
 }
 
-// AtLeastTimes lets you specify a minimum number of times this method is expected to be
-// called.
-func (d MyFuncFuncMockDescriptorWithReturn) AtLeastTimes(times int) MyFuncMockDescriptor {
-	return d.TimesMatching(func(got int) error {
-		if got < times {
-			return fmt.Errorf("expected at least %d calls, got %d", times, got)
-		}
-		return nil
-	})
-}
-
-// TimesMatching lets you pass a function to accept or reject the number of times
-// this method has been called.
-func (d MyFuncFuncMockDescriptorWithReturn) TimesMatching(f func(times int) error) MyFuncMockDescriptor {
-	d.methodDesc.times = f
-	d.methodDesc.done()
-	return d.methodDesc.mockDesc
-}
-
-// Mock finishes the description and produces a mock.
-//
-// See MyFuncMockDescriptor.Mock for details.
-func (d MyFuncFuncMockDescriptorWithReturn) Mock() (m MyFuncMock, assert func(t interface{ Errorf(string, ...interface{})  }) (ok bool)) {
-	d.methodDesc.done()
-	return d.methodDesc.mockDesc.Mock()
-}
-	
-// Func finishes the current description for method MyFunc.Func and
-// starts describing for method Func.
-//
-// See MyFuncMockDescriptor.Func for details.
-func (d MyFuncFuncMockDescriptorWithReturn) Func() *MyFuncFuncMockDescriptor {
-	d.methodDesc.done()
-	return d.methodDesc.mockDesc.newMyFuncFuncMockDescriptor()
-}
-	
-func (d *MyFuncFuncMockDescriptor) done() {
+// done ends the description, adding it to the mock descriptor.
+func (d *MyFuncFuncMockDescriptor) done() MyFuncMockDescribedCall {
 	d.mockDesc.descriptors_Func = append(d.mockDesc.descriptors_Func, d)
+	return MyFuncMockDescribedCall{d.mockDesc, &d.times}
 }
-	
+
 // Mock returns a mock for MyFunc that calls the functions
 // defined as struct fields in the receiver.
 //
@@ -3,8 +3,9 @@
 package generated_test
 
 import (
-	examples "github.com/tcard/make.go.mock/examples"
-	os "os"
+	"os"
+
+	"github.com/tcard/make.go.mock/examples"
 )
 
 // MyInterfaceMocker builds mocks for type MyInterface.
@@ -15,11 +16,16 @@ import (
 //
 // If the original type was a function, it is mapped to field Func.
 type MyInterfaceMocker struct {
-	Boring                 func()
-	EmbeddedMethod         func()
+	// Boring takes nothing and returns nothing.
+	Boring         func()
+	EmbeddedMethod func()
+	// ReturnSomethingAtLeast returns an int, which is something at least.
 	ReturnSomethingAtLeast func() (r0 int)
-	ShouldBeFun            func(a0 int, a1 map[string]map[examples.MyStruct]bool, a2 ...chan<- <-chan struct{}) (r0 int, r1 error)
-	StdSomething           func(f *os.File, ints ...int) (named bool)
+	// ShouldBeFun takes some complex types, and a variadic argument. Its
+	// parameters are unnamed, so they get synthetic names in the mock.
+	ShouldBeFun func(a0 int, a1 map[string]map[examples.MyStruct]bool, a2 ...chan<- <-chan struct{}) (r0 int, r1 error)
+	// StdSomething takes a type from the standard library.
+	StdSomething func(f *os.File, ints ...int) (named bool)
 }
 
 // Mock returns a mock for MyInterface that calls the functions
@@ -57,9 +63,14 @@ func (m _makegomock_MyInterfaceMockFromMocker) StdSomething(f *os.File, ints ...
 // It is copied from the original just to avoid introducing a dependency on
 // MyInterface's package.
 type MyInterfaceMock interface {
+	// Boring takes nothing and returns nothing.
 	Boring()
 	EmbeddedMethod()
+	// ReturnSomethingAtLeast returns an int, which is something at least.
 	ReturnSomethingAtLeast() (r0 int)
+	// ShouldBeFun takes some complex types, and a variadic argument. Its
+	// parameters are unnamed, so they get synthetic names in the mock.
 	ShouldBeFun(a0 int, a1 map[string]map[examples.MyStruct]bool, a2 ...chan<- <-chan struct{}) (r0 int, r1 error)
+	// StdSomething takes a type from the standard library.
 	StdSomething(f *os.File, ints ...int) (named bool)
 }
@@ -0,0 +1,60 @@
+package examples
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenericInterface(t *testing.T) {
+	var repo Repository[string, int]
+	repo, assertMock := (&RepositoryMocker[string, int]{}).Describe().
+		Get().Takes("foo").Returns(42, nil).Times(1).
+		Put().Takes("foo").And(43).Returns(nil).Times(1).
+		Mock()
+	defer assertMock(t)
+
+	v, err := repo.Get("foo")
+	assert.Equal(t, 42, v)
+	assert.NoError(t, err)
+	assert.NoError(t, repo.Put("foo", v+1))
+}
+
+func TestGenericInterfaceInstantiation(t *testing.T) {
+	expectedErr := errors.New("expected")
+	var repo Repository[string, int]
+	repo, assertMock := (&StringIntRepositoryMocker{}).Describe().
+		Keys().Returns([]string{"foo", "bar"}).Times(1).
+		Put().TakesAny().AndMatching(func(value int) error {
+		if value < 0 {
+			return errors.New("negative value")
+		}
+		return nil
+	}).Returns(expectedErr).
+		Mock()
+	defer assertMock(t)
+
+	assert.Equal(t, []string{"foo", "bar"}, repo.Keys())
+	assert.Equal(t, expectedErr, repo.Put("foo", 1))
+	assert.Panics(t, func() {
+		repo.Put("foo", -1)
+	})
+}
+
+type myString string
+
+func TestGenericFunc(t *testing.T) {
+	mock, assertMock := (&MapperMocker[int, myString]{}).Describe().
+		Func().TakesAny().ReturnsFrom(func(i int) (myString, error) {
+		return myString(strconv.Itoa(i)), nil
+	}).AtLeastTimes(1).
+		Mock()
+	defer assertMock(t)
+
+	f := Mapper[int, myString](mock)
+	got, err := f(123)
+	assert.Equal(t, myString("123"), got)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,84 @@
+package examples
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fetchUser is the kind of variable that code under test calls, so that tests
+// can replace it.
+var fetchUser = FetchUser
+
+func TestInstall(t *testing.T) {
+	user := &User{ID: "42", Name: "Alice"}
+	t.Run("installed", func(t *testing.T) {
+		(&FetchUserMocker{}).Describe().
+			Func().TakesAny().And("42").Returns(user, nil).Times(1).
+			Install(&fetchUser, t)
+
+		got, err := fetchUser(context.Background(), "42")
+		assert.NoError(t, err)
+		assert.Equal(t, user, got)
+	})
+
+	_, err := fetchUser(context.Background(), "42")
+	assert.EqualError(t, err, "user 42 not found")
+}
+
+func TestInstallAsserts(t *testing.T) {
+	var ft cleanupT
+	(&FetchUserMocker{}).Describe().
+		Func().TakesAny().And("42").Returns(nil, nil).Times(1).
+		Install(&fetchUser, &ft)
+	ft.cleanup()
+
+	assert.Equal(t, []string{"mock for FetchUser.Func: expected exactly 1 calls, got 0"}, ft.errs)
+}
+
+func TestInstallTwice(t *testing.T) {
+	var ft cleanupT
+	desc := (&FetchUserMocker{}).Describe()
+	desc.Install(&fetchUser, &ft)
+	defer ft.cleanup()
+
+	assert.PanicsWithValue(t, "a mock for FetchUser is already installed into the target variable; tests installing it can't run in parallel", func() {
+		desc.Install(&fetchUser, &ft)
+	})
+}
+
+func TestInstallFuncType(t *testing.T) {
+	var f Mapper[int, string]
+	t.Run("installed", func(t *testing.T) {
+		(&MapperMocker[int, string]{}).Describe().
+			Func().Takes(1).Returns("one", nil).Times(1).
+			Install(&f, t)
+
+		got, err := f(1)
+		assert.NoError(t, err)
+		assert.Equal(t, "one", got)
+	})
+	assert.Nil(t, f)
+}
+
+type cleanupT struct {
+	cleanups []func()
+	errs     []string
+}
+
+func (t *cleanupT) Cleanup(f func()) {
+	t.cleanups = append(t.cleanups, f)
+}
+
+func (t *cleanupT) Errorf(s string, args ...interface{}) {
+	t.errs = append(t.errs, fmt.Sprintf(s, args...))
+}
+
+func (t *cleanupT) cleanup() {
+	for i := len(t.cleanups) - 1; i >= 0; i-- {
+		t.cleanups[i]()
+	}
+	t.cleanups = nil
+}
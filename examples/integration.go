@@ -0,0 +1,10 @@
+//go:build integration
+
+package examples
+
+// Notifier is only declared with the integration build tag. Its mock is
+// generated with -tags to find it, and -buildtag to restrict the mock to that
+// tag too.
+type Notifier interface {
+	Notify(msg string) error
+}
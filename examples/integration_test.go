@@ -0,0 +1,19 @@
+//go:build integration
+
+package examples
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMockBehindBuildTag(t *testing.T) {
+	mock, assertMock := (&NotifierMocker{}).Describe().
+		Notify().Takes("hello").Returns(nil).Times(1).
+		Mock()
+	defer assertMock(t)
+
+	var notifier Notifier = mock
+	assert.NoError(t, notifier.Notify("hello"))
+}
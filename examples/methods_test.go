@@ -0,0 +1,36 @@
+package examples
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMockSelectedMethods(t *testing.T) {
+	mock, assertMock := (&BigClientMocker{}).Describe().
+		Put().Takes("foo").And("bar").Returns(nil).Times(1).
+		Get().Takes("foo").Returns("bar", nil).Times(1).
+		Mock()
+	defer assertMock(t)
+
+	var client BigClient = mock
+	assert.NoError(t, client.Put("foo", "bar"))
+	value, err := client.Get("foo")
+	assert.NoError(t, err)
+	assert.Equal(t, "bar", value)
+
+	assert.PanicsWithValue(t, "BigClient.Delete is not mocked: regenerate with it in -methods", func() {
+		_ = client.Delete("foo")
+	})
+}
+
+func TestMockExcludedMethods(t *testing.T) {
+	var client BigClient = (&BigClientWithoutWatchMocker{
+		Delete: func(key string) error { return nil },
+	}).Mock()
+
+	assert.NoError(t, client.Delete("foo"))
+	assert.PanicsWithValue(t, "BigClient.Watch is not mocked: regenerate without it in -exclude-methods", func() {
+		client.Watch("foo", func(string) {})
+	})
+}
@@ -0,0 +1,62 @@
+// Code generated by github.com/tcard/make.go.mock. DO NOT EDIT.
+
+package examples
+
+// BigClientWithoutWatchMocker builds mocks for type BigClient.
+//
+// Its fields match the original type's methods. Set those you expect to be
+// called, then call the Mock method to get a mock that implements the original
+// type.
+//
+// If the original type was a function, it is mapped to field Func.
+//
+// Only some of the original type's methods are mocked; the rest panic if
+// called.
+type BigClientWithoutWatchMocker struct {
+	Delete func(key string) (r0 error)
+	Get    func(key string) (r0 string, r1 error)
+	List   func(prefix string) (r0 []string, r1 error)
+	Put    func(key string, value string) (r0 error)
+}
+
+// Mock returns a mock for BigClient that calls the functions
+// defined as struct fields in the receiver.
+func (m *BigClientWithoutWatchMocker) Mock() BigClientWithoutWatchMock {
+	return _makegomock_BigClientWithoutWatchMockFromMocker{m}
+}
+
+type _makegomock_BigClientWithoutWatchMockFromMocker struct {
+	m *BigClientWithoutWatchMocker
+}
+
+func (m _makegomock_BigClientWithoutWatchMockFromMocker) Delete(key string) (r0 error) {
+	return m.m.Delete(key)
+}
+
+func (m _makegomock_BigClientWithoutWatchMockFromMocker) Get(key string) (r0 string, r1 error) {
+	return m.m.Get(key)
+}
+
+func (m _makegomock_BigClientWithoutWatchMockFromMocker) List(prefix string) (r0 []string, r1 error) {
+	return m.m.List(prefix)
+}
+
+func (m _makegomock_BigClientWithoutWatchMockFromMocker) Put(key string, value string) (r0 error) {
+	return m.m.Put(key, value)
+}
+
+func (m _makegomock_BigClientWithoutWatchMockFromMocker) Watch(key string, f func(value string)) (stop func()) {
+	panic("BigClient.Watch is not mocked: regenerate without it in -exclude-methods")
+}
+
+// BigClientWithoutWatchMock is a mock with the same underlying type as BigClient.
+//
+// It is copied from the original just to avoid introducing a dependency on
+// BigClient's package.
+type BigClientWithoutWatchMock interface {
+	Delete(key string) (r0 error)
+	Get(key string) (r0 string, r1 error)
+	List(prefix string) (r0 []string, r1 error)
+	Put(key string, value string) (r0 error)
+	Watch(key string, f func(value string)) (stop func())
+}
@@ -0,0 +1,553 @@
+// Code generated by github.com/tcard/make.go.mock. DO NOT EDIT.
+
+package examples
+
+import (
+	"fmt"
+	"runtime"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// BigClientMocker builds mocks for type BigClient.
+//
+// Its fields match the original type's methods. Set those you expect to be
+// called, then call the Mock method to get a mock that implements the original
+// type.
+//
+// If the original type was a function, it is mapped to field Func.
+//
+// Only some of the original type's methods are mocked; the rest panic if
+// called.
+//
+// The Describe method is a shortcut to define this struct's fields in a
+// declarative manner.
+type BigClientMocker struct {
+	Get func(key string) (r0 string, r1 error)
+	Put func(key string, value string) (r0 error)
+}
+
+// Describe lets you describe how the methods on the resulting mock are expected
+// to be called and what they will return.
+//
+// When you're done describing methods, call Mock to get a mock that implements
+// the behavior you described.
+func (m *BigClientMocker) Describe() BigClientMockDescriptor {
+	return BigClientMockDescriptor{m: m}
+}
+
+// A BigClientMockDescriptor lets you describe how the methods on the resulting mock are expected
+// to be called and what they will return.
+//
+// When you're done describing methods, call its Mock method to get a mock that
+// implements the behavior you described.
+type BigClientMockDescriptor struct {
+	m               *BigClientMocker
+	descriptors_Get []*BigClientGetMockDescriptor
+	descriptors_Put []*BigClientPutMockDescriptor
+}
+
+// Mock returns a mock that the BigClient interface, following the behavior
+// described by the descriptor methods.
+//
+// It also returns a function that should be called before the test is done to
+// ensure that the expected number of calls to the mock methods happened. You
+// can pass a *testing.T to it, since it implements the interface it wants.
+func (d BigClientMockDescriptor) Mock() (m BigClientMock, assert func(t interface {
+	Errorf(s string, args ...interface{})
+}) (ok bool)) {
+	assert = d.done()
+	return d.m.Mock(), assert
+}
+
+func (d BigClientMockDescriptor) done() func(t interface {
+	Errorf(s string, args ...interface{})
+}) bool {
+	var atAssert []func() (method string, errs []string)
+	type specErrs struct {
+		fileLine string
+		errs     []string
+	}
+
+	if len(d.descriptors_Get) > 0 {
+		for _, desc := range d.descriptors_Get {
+			desc := desc
+			calls := 0
+			prev := desc.call
+			desc.call = func(key string) (r0 string, r1 error) {
+				calls++
+				return prev(key)
+			}
+			atAssert = append(atAssert, func() (method string, errs []string) {
+				err := desc.times(calls)
+				if err != nil {
+					return "Get", []string{err.Error()}
+				}
+				return "", nil
+			})
+		}
+		d.m.Get = func(key string) (r0 string, r1 error) {
+			var matching []*BigClientGetMockDescriptor
+			var allErrs []specErrs
+			for _, desc := range d.descriptors_Get {
+				errs := desc.argValidator(key)
+				if len(errs) > 0 {
+					allErrs = append(allErrs, specErrs{desc.fileLine, errs})
+				} else {
+					matching = append(matching, desc)
+				}
+			}
+			if len(matching) == 1 {
+				return matching[0].call(key)
+			}
+			var args string
+			for i, arg := range []interface{}{key} {
+				if i != 0 {
+					args += "\n\t"
+				}
+				args += fmt.Sprintf("%#v", arg)
+			}
+			if len(matching) == 0 {
+				matchingErrs := ""
+				for _, errs := range allErrs {
+					matchingErrs += "\n\tcandidate described at " + errs.fileLine + ":\n"
+					for _, err := range errs.errs {
+						matchingErrs += "\n\t\t" + err
+					}
+				}
+				panic(fmt.Errorf("no matching candidate for call to mock for BigClient.Get with args:\n\n\t%+v\n\nfailing candidates:\n%s", args, matchingErrs))
+			}
+			matchingLines := ""
+			for _, m := range matching {
+				matchingLines += "\n\tcandidate described at " + m.fileLine
+			}
+			panic(fmt.Errorf("more than one candidate for call to mock for BigClient.Get with args:\n\n\t%+v\n\nmatching candidates:\n%s", args, matchingLines))
+		}
+	} else {
+		d.m.Get = func(key string) (r0 string, r1 error) {
+			panic("unexpected call to mock for BigClient.Get")
+		}
+	}
+	if len(d.descriptors_Put) > 0 {
+		for _, desc := range d.descriptors_Put {
+			desc := desc
+			calls := 0
+			prev := desc.call
+			desc.call = func(key string, value string) (r0 error) {
+				calls++
+				return prev(key, value)
+			}
+			atAssert = append(atAssert, func() (method string, errs []string) {
+				err := desc.times(calls)
+				if err != nil {
+					return "Put", []string{err.Error()}
+				}
+				return "", nil
+			})
+		}
+		d.m.Put = func(key string, value string) (r0 error) {
+			var matching []*BigClientPutMockDescriptor
+			var allErrs []specErrs
+			for _, desc := range d.descriptors_Put {
+				errs := desc.argValidator(key, value)
+				if len(errs) > 0 {
+					allErrs = append(allErrs, specErrs{desc.fileLine, errs})
+				} else {
+					matching = append(matching, desc)
+				}
+			}
+			if len(matching) == 1 {
+				return matching[0].call(key, value)
+			}
+			var args string
+			for i, arg := range []interface{}{key, value} {
+				if i != 0 {
+					args += "\n\t"
+				}
+				args += fmt.Sprintf("%#v", arg)
+			}
+			if len(matching) == 0 {
+				matchingErrs := ""
+				for _, errs := range allErrs {
+					matchingErrs += "\n\tcandidate described at " + errs.fileLine + ":\n"
+					for _, err := range errs.errs {
+						matchingErrs += "\n\t\t" + err
+					}
+				}
+				panic(fmt.Errorf("no matching candidate for call to mock for BigClient.Put with args:\n\n\t%+v\n\nfailing candidates:\n%s", args, matchingErrs))
+			}
+			matchingLines := ""
+			for _, m := range matching {
+				matchingLines += "\n\tcandidate described at " + m.fileLine
+			}
+			panic(fmt.Errorf("more than one candidate for call to mock for BigClient.Put with args:\n\n\t%+v\n\nmatching candidates:\n%s", args, matchingLines))
+		}
+	} else {
+		d.m.Put = func(key string, value string) (r0 error) {
+			panic("unexpected call to mock for BigClient.Put")
+		}
+	}
+	return func(t interface {
+		Errorf(s string, args ...interface{})
+	}) bool {
+		ok := true
+		for _, assert := range atAssert {
+			method, errs := assert()
+			for _, err := range errs {
+				ok = false
+				t.Errorf("mock for BigClient.%s: %s", method, err)
+			}
+		}
+		return ok
+	}
+}
+
+// BigClientMockDescribedCall is the last step in the description of a way that a
+// method of BigClient is to behave when called, with all expected parameters
+// and the resulting values specified.
+//
+// It has methods to describe the times the method is expected to be called.
+// Otherwise, through the embedded BigClientMockDescriptor, you can start
+// another method call description, or you can call Mock to end the description
+// and get the resulting mock.
+type BigClientMockDescribedCall struct {
+	BigClientMockDescriptor
+	times *func(int) error
+}
+
+// Times lets you specify a exact number of times this method is expected to be
+// called.
+func (d BigClientMockDescribedCall) Times(times int) BigClientMockDescriptor {
+	return d.TimesMatching(func(got int) error {
+		if got != times {
+			return fmt.Errorf("expected exactly %d calls, got %d", times, got)
+		}
+		return nil
+	})
+}
+
+// AtLeastTimes lets you specify a minimum number of times this method is expected to be
+// called.
+func (d BigClientMockDescribedCall) AtLeastTimes(times int) BigClientMockDescriptor {
+	return d.TimesMatching(func(got int) error {
+		if got < times {
+			return fmt.Errorf("expected at least %d calls, got %d", times, got)
+		}
+		return nil
+	})
+}
+
+// TimesMatching lets you pass a function to accept or reject the number of times
+// this method has been called.
+func (d BigClientMockDescribedCall) TimesMatching(f func(times int) error) BigClientMockDescriptor {
+	*d.times = f
+	return d.BigClientMockDescriptor
+}
+
+// Get starts describing a way method BigClient.Get is expected to be called
+// and what it should return.
+//
+// You can call it several times to describe different behaviors, each matching different parameters.
+func (d BigClientMockDescriptor) Get() *BigClientGetMockDescriptor {
+	return d.newBigClientGetMockDescriptor()
+}
+
+func (d BigClientMockDescriptor) newBigClientGetMockDescriptor() *BigClientGetMockDescriptor {
+	_, file, line, _ := runtime.Caller(2)
+	return &BigClientGetMockDescriptor{
+		mockDesc:     d,
+		times:        func(int) error { return nil },
+		argValidator: func(got_key string) []string { return nil },
+		fileLine:     fmt.Sprintf("%s:%d", file, line),
+	}
+}
+
+// BigClientGetMockDescriptor is returned by BigClientMockDescriptor.Get and
+// holds methods to describe the mock for method BigClient.Get.
+type BigClientGetMockDescriptor struct {
+	mockDesc     BigClientMockDescriptor
+	times        func(int) error
+	argValidator func(got_key string) []string
+	call         func(key string) (r0 string, r1 error)
+	fileLine     string
+}
+
+// Takes lets you specify a value with which the actual value passed to
+// the mocked method BigClient.Get as parameter #1
+// will be compared.
+//
+// Package "github.com/google/go-cmp/cmp" is used to do the comparison. You can
+// pass extra options for it.
+//
+// If you want to accept any value, use TakesAny.
+//
+// If you want more complex validation logic, use TakesMatching.
+func (d *BigClientGetMockDescriptor) Takes(key string, opts ...cmp.Option) BigClientGetMockDescriptorWith1Arg {
+	prev := d.argValidator
+	d.argValidator = func(got_key string) []string {
+		errMsgs := prev(got_key)
+		if diff := cmp.Diff(key, got_key, opts...); diff != "" {
+			errMsgs = append(errMsgs, "parameter #1 mismatch:\n"+diff)
+		}
+		return errMsgs
+	}
+	return BigClientGetMockDescriptorWith1Arg{d}
+}
+
+// TakesAny declares that any value passed to the mocked method
+// Get as parameter #1 is expected.
+func (d *BigClientGetMockDescriptor) TakesAny() BigClientGetMockDescriptorWith1Arg {
+	return BigClientGetMockDescriptorWith1Arg{d}
+}
+
+// TakesMatching lets you pass a function to accept or reject the actual
+// value passed to the mocked method BigClient.Get as parameter #1.
+func (d *BigClientGetMockDescriptor) TakesMatching(match func(key string) error) BigClientGetMockDescriptorWith1Arg {
+	prev := d.argValidator
+	d.argValidator = func(got_key string) []string {
+		errMsgs := prev(got_key)
+		if err := match(got_key); err != nil {
+			errMsgs = append(errMsgs, "parameter \"key\" custom matcher error: "+err.Error())
+		}
+		return errMsgs
+	}
+	return BigClientGetMockDescriptorWith1Arg{d}
+}
+
+// BigClientGetMockDescriptorWith1Arg is a step forward in the description of a way that the
+// method BigClient.Get is expected to be called, with 1
+// arguments specified.
+//
+// It has methods to describe the next argument, if there's any left, or the
+// return values otherwise.
+type BigClientGetMockDescriptorWith1Arg struct {
+	methodDesc *BigClientGetMockDescriptor
+}
+
+// Returns lets you specify the values that the mocked method BigClient.Get,
+// if called with values matching the expectations, will return.
+func (d BigClientGetMockDescriptorWith1Arg) Returns(r0 string, r1 error) BigClientMockDescribedCall {
+	return d.ReturnsFrom(func(string) (string, error) {
+		return r0, r1
+	})
+}
+
+// ReturnsFrom lets you specify the values that the mocked method BigClient.Get,
+// if called with values matching the expectations, will return.
+//
+// It passes such passed values to a function that then returns the return values.
+func (d BigClientGetMockDescriptorWith1Arg) ReturnsFrom(f func(key string) (r0 string, r1 error)) BigClientMockDescribedCall {
+	d.methodDesc.call = f
+	return d.methodDesc.done()
+}
+
+// done ends the description, adding it to the mock descriptor.
+func (d *BigClientGetMockDescriptor) done() BigClientMockDescribedCall {
+	d.mockDesc.descriptors_Get = append(d.mockDesc.descriptors_Get, d)
+	return BigClientMockDescribedCall{d.mockDesc, &d.times}
+}
+
+// Put starts describing a way method BigClient.Put is expected to be called
+// and what it should return.
+//
+// You can call it several times to describe different behaviors, each matching different parameters.
+func (d BigClientMockDescriptor) Put() *BigClientPutMockDescriptor {
+	return d.newBigClientPutMockDescriptor()
+}
+
+func (d BigClientMockDescriptor) newBigClientPutMockDescriptor() *BigClientPutMockDescriptor {
+	_, file, line, _ := runtime.Caller(2)
+	return &BigClientPutMockDescriptor{
+		mockDesc:     d,
+		times:        func(int) error { return nil },
+		argValidator: func(got_key string, got_value string) []string { return nil },
+		fileLine:     fmt.Sprintf("%s:%d", file, line),
+	}
+}
+
+// BigClientPutMockDescriptor is returned by BigClientMockDescriptor.Put and
+// holds methods to describe the mock for method BigClient.Put.
+type BigClientPutMockDescriptor struct {
+	mockDesc     BigClientMockDescriptor
+	times        func(int) error
+	argValidator func(got_key string, got_value string) []string
+	call         func(key string, value string) (r0 error)
+	fileLine     string
+}
+
+// Takes lets you specify a value with which the actual value passed to
+// the mocked method BigClient.Put as parameter #1
+// will be compared.
+//
+// Package "github.com/google/go-cmp/cmp" is used to do the comparison. You can
+// pass extra options for it.
+//
+// If you want to accept any value, use TakesAny.
+//
+// If you want more complex validation logic, use TakesMatching.
+func (d *BigClientPutMockDescriptor) Takes(key string, opts ...cmp.Option) BigClientPutMockDescriptorWith1Arg {
+	prev := d.argValidator
+	d.argValidator = func(got_key string, got_value string) []string {
+		errMsgs := prev(got_key, got_value)
+		if diff := cmp.Diff(key, got_key, opts...); diff != "" {
+			errMsgs = append(errMsgs, "parameter #1 mismatch:\n"+diff)
+		}
+		return errMsgs
+	}
+	return BigClientPutMockDescriptorWith1Arg{d}
+}
+
+// TakesAny declares that any value passed to the mocked method
+// Put as parameter #1 is expected.
+func (d *BigClientPutMockDescriptor) TakesAny() BigClientPutMockDescriptorWith1Arg {
+	return BigClientPutMockDescriptorWith1Arg{d}
+}
+
+// TakesMatching lets you pass a function to accept or reject the actual
+// value passed to the mocked method BigClient.Put as parameter #1.
+func (d *BigClientPutMockDescriptor) TakesMatching(match func(key string) error) BigClientPutMockDescriptorWith1Arg {
+	prev := d.argValidator
+	d.argValidator = func(got_key string, got_value string) []string {
+		errMsgs := prev(got_key, got_value)
+		if err := match(got_key); err != nil {
+			errMsgs = append(errMsgs, "parameter \"key\" custom matcher error: "+err.Error())
+		}
+		return errMsgs
+	}
+	return BigClientPutMockDescriptorWith1Arg{d}
+}
+
+// BigClientPutMockDescriptorWith1Arg is a step forward in the description of a way that the
+// method BigClient.Put is expected to be called, with 1
+// arguments specified.
+//
+// It has methods to describe the next argument, if there's any left, or the
+// return values otherwise.
+type BigClientPutMockDescriptorWith1Arg struct {
+	methodDesc *BigClientPutMockDescriptor
+}
+
+// And lets you specify a value with which the actual value passed to
+// the mocked method BigClient.Put as parameter #2
+// will be compared.
+//
+// Package "github.com/google/go-cmp/cmp" is used to do the comparison. You can
+// pass extra options for it.
+//
+// If you want to accept any value, use AndAny.
+//
+// If you want more complex validation logic, use AndMatching.
+func (d BigClientPutMockDescriptorWith1Arg) And(value string, opts ...cmp.Option) BigClientPutMockDescriptorWith2Args {
+	prev := d.methodDesc.argValidator
+	d.methodDesc.argValidator = func(got_key string, got_value string) []string {
+		errMsgs := prev(got_key, got_value)
+		if diff := cmp.Diff(value, got_value, opts...); diff != "" {
+			errMsgs = append(errMsgs, "parameter #2 mismatch:\n"+diff)
+		}
+		return errMsgs
+	}
+	return BigClientPutMockDescriptorWith2Args{d.methodDesc}
+}
+
+// AndAny declares that any value passed to the mocked method
+// Put as parameter #2 is expected.
+func (d BigClientPutMockDescriptorWith1Arg) AndAny() BigClientPutMockDescriptorWith2Args {
+	return BigClientPutMockDescriptorWith2Args{d.methodDesc}
+}
+
+// AndMatching lets you pass a function to accept or reject the actual
+// value passed to the mocked method BigClient.Put as parameter #2.
+func (d BigClientPutMockDescriptorWith1Arg) AndMatching(match func(value string) error) BigClientPutMockDescriptorWith2Args {
+	prev := d.methodDesc.argValidator
+	d.methodDesc.argValidator = func(got_key string, got_value string) []string {
+		errMsgs := prev(got_key, got_value)
+		if err := match(got_value); err != nil {
+			errMsgs = append(errMsgs, "parameter \"value\" custom matcher error: "+err.Error())
+		}
+		return errMsgs
+	}
+	return BigClientPutMockDescriptorWith2Args{d.methodDesc}
+}
+
+// BigClientPutMockDescriptorWith2Args is a step forward in the description of a way that the
+// method BigClient.Put is expected to be called, with 2
+// arguments specified.
+//
+// It has methods to describe the next argument, if there's any left, or the
+// return values otherwise.
+type BigClientPutMockDescriptorWith2Args struct {
+	methodDesc *BigClientPutMockDescriptor
+}
+
+// Returns lets you specify the values that the mocked method BigClient.Put,
+// if called with values matching the expectations, will return.
+func (d BigClientPutMockDescriptorWith2Args) Returns(r0 error) BigClientMockDescribedCall {
+	return d.ReturnsFrom(func(string, string) error {
+		return r0
+	})
+}
+
+// ReturnsFrom lets you specify the values that the mocked method BigClient.Put,
+// if called with values matching the expectations, will return.
+//
+// It passes such passed values to a function that then returns the return values.
+func (d BigClientPutMockDescriptorWith2Args) ReturnsFrom(f func(key string, value string) (r0 error)) BigClientMockDescribedCall {
+	d.methodDesc.call = f
+	return d.methodDesc.done()
+}
+
+// done ends the description, adding it to the mock descriptor.
+func (d *BigClientPutMockDescriptor) done() BigClientMockDescribedCall {
+	d.mockDesc.descriptors_Put = append(d.mockDesc.descriptors_Put, d)
+	return BigClientMockDescribedCall{d.mockDesc, &d.times}
+}
+
+// Mock returns a mock for BigClient that calls the functions
+// defined as struct fields in the receiver.
+//
+// You probably want to use Describe instead.
+func (m *BigClientMocker) Mock() BigClientMock {
+	return _makegomock_BigClientMockFromMocker{m}
+}
+
+type _makegomock_BigClientMockFromMocker struct {
+	m *BigClientMocker
+}
+
+func (m _makegomock_BigClientMockFromMocker) Delete(key string) (r0 error) {
+	panic("BigClient.Delete is not mocked: regenerate with it in -methods")
+}
+
+func (m _makegomock_BigClientMockFromMocker) Get(key string) (r0 string, r1 error) {
+	return m.m.Get(key)
+}
+
+func (m _makegomock_BigClientMockFromMocker) List(prefix string) (r0 []string, r1 error) {
+	panic("BigClient.List is not mocked: regenerate with it in -methods")
+}
+
+func (m _makegomock_BigClientMockFromMocker) Put(key string, value string) (r0 error) {
+	return m.m.Put(key, value)
+}
+
+func (m _makegomock_BigClientMockFromMocker) Watch(key string, f func(value string)) (stop func()) {
+	panic("BigClient.Watch is not mocked: regenerate with it in -methods")
+}
+
+// BigClientMock is a mock with the same underlying type as BigClient.
+//
+// It is copied from the original just to avoid introducing a dependency on
+// BigClient's package.
+type BigClientMock interface {
+	Delete(key string) (r0 error)
+	Get(key string) (r0 string, r1 error)
+	List(prefix string) (r0 []string, r1 error)
+	Put(key string, value string) (r0 error)
+	Watch(key string, f func(value string)) (stop func())
+}
+
+// This fails to compile if BigClientMock no longer matches
+// BigClient, which means that the mock must be regenerated.
+func _() {
+	var _ BigClient = (*BigClientMocker)(nil).Mock()
+}
@@ -3,10 +3,11 @@
 package examples
 
 import (
-	fmt "fmt"
-	cmp "github.com/google/go-cmp/cmp"
-	os "os"
-	runtime "runtime"
+	"fmt"
+	"os"
+	"runtime"
+
+	"github.com/google/go-cmp/cmp"
 )
 
 // DifferentNameMocker builds mocks for type MyInterface.
@@ -20,11 +21,16 @@ import (
 // The Describe method is a shortcut to define this struct's fields in a
 // declarative manner.
 type DifferentNameMocker struct {
-	Boring                 func()
-	EmbeddedMethod         func()
+	// Boring takes nothing and returns nothing.
+	Boring         func()
+	EmbeddedMethod func()
+	// ReturnSomethingAtLeast returns an int, which is something at least.
 	ReturnSomethingAtLeast func() (r0 int)
-	ShouldBeFun            func(a0 int, a1 map[string]map[MyStruct]bool, a2 ...chan<- <-chan struct{}) (r0 int, r1 error)
-	StdSomething           func(f *os.File, ints ...int) (named bool)
+	// ShouldBeFun takes some complex types, and a variadic argument. Its
+	// parameters are unnamed, so they get synthetic names in the mock.
+	ShouldBeFun func(a0 int, a1 map[string]map[MyStruct]bool, a2 ...chan<- <-chan struct{}) (r0 int, r1 error)
+	// StdSomething takes a type from the standard library.
+	StdSomething func(f *os.File, ints ...int) (named bool)
 }
 
 // Describe lets you describe how the methods on the resulting mock are expected
@@ -42,12 +48,12 @@ func (m *DifferentNameMocker) Describe() DifferentNameMockDescriptor {
 // When you're done describing methods, call its Mock method to get a mock that
 // implements the behavior you described.
 type DifferentNameMockDescriptor struct {
-	m *DifferentNameMocker
-	descriptors_Boring []*DifferentNameBoringMockDescriptor
-	descriptors_EmbeddedMethod []*DifferentNameEmbeddedMethodMockDescriptor
+	m                                  *DifferentNameMocker
+	descriptors_Boring                 []*DifferentNameBoringMockDescriptor
+	descriptors_EmbeddedMethod         []*DifferentNameEmbeddedMethodMockDescriptor
 	descriptors_ReturnSomethingAtLeast []*DifferentNameReturnSomethingAtLeastMockDescriptor
-	descriptors_ShouldBeFun []*DifferentNameShouldBeFunMockDescriptor
-	descriptors_StdSomething []*DifferentNameStdSomethingMockDescriptor
+	descriptors_ShouldBeFun            []*DifferentNameShouldBeFunMockDescriptor
+	descriptors_StdSomething           []*DifferentNameStdSomethingMockDescriptor
 }
 
 // Mock returns a mock that the MyInterface interface, following the behavior
@@ -56,18 +62,22 @@ type DifferentNameMockDescriptor struct {
 // It also returns a function that should be called before the test is done to
 // ensure that the expected number of calls to the mock methods happened. You
 // can pass a *testing.T to it, since it implements the interface it wants.
-func (d DifferentNameMockDescriptor) Mock() (m DifferentNameMock, assert func(t interface{ Errorf(s string, args ...interface{})  }) (ok bool)) {
+func (d DifferentNameMockDescriptor) Mock() (m DifferentNameMock, assert func(t interface {
+	Errorf(s string, args ...interface{})
+}) (ok bool)) {
 	assert = d.done()
 	return d.m.Mock(), assert
 }
 
-func (d DifferentNameMockDescriptor) done() func(t interface{ Errorf(s string, args ...interface{})  }) bool {
+func (d DifferentNameMockDescriptor) done() func(t interface {
+	Errorf(s string, args ...interface{})
+}) bool {
 	var atAssert []func() (method string, errs []string)
 	type specErrs struct {
 		fileLine string
-		errs []string
+		errs     []string
 	}
-	
+
 	if len(d.descriptors_Boring) > 0 {
 		for _, desc := range d.descriptors_Boring {
 			desc := desc
@@ -108,7 +118,7 @@ func (d DifferentNameMockDescriptor) done() func(t interface{ Errorf(s string, a
 			if len(matching) == 0 {
 				matchingErrs := ""
 				for _, errs := range allErrs {
-					matchingErrs += "\n\tcandidate described at "+errs.fileLine+":\n"
+					matchingErrs += "\n\tcandidate described at " + errs.fileLine + ":\n"
 					for _, err := range errs.errs {
 						matchingErrs += "\n\t\t" + err
 					}
@@ -166,7 +176,7 @@ func (d DifferentNameMockDescriptor) done() func(t interface{ Errorf(s string, a
 			if len(matching) == 0 {
 				matchingErrs := ""
 				for _, errs := range allErrs {
-					matchingErrs += "\n\tcandidate described at "+errs.fileLine+":\n"
+					matchingErrs += "\n\tcandidate described at " + errs.fileLine + ":\n"
 					for _, err := range errs.errs {
 						matchingErrs += "\n\t\t" + err
 					}
@@ -225,7 +235,7 @@ func (d DifferentNameMockDescriptor) done() func(t interface{ Errorf(s string, a
 			if len(matching) == 0 {
 				matchingErrs := ""
 				for _, errs := range allErrs {
-					matchingErrs += "\n\tcandidate described at "+errs.fileLine+":\n"
+					matchingErrs += "\n\tcandidate described at " + errs.fileLine + ":\n"
 					for _, err := range errs.errs {
 						matchingErrs += "\n\t\t" + err
 					}
@@ -284,7 +294,7 @@ func (d DifferentNameMockDescriptor) done() func(t interface{ Errorf(s string, a
 			if len(matching) == 0 {
 				matchingErrs := ""
 				for _, errs := range allErrs {
-					matchingErrs += "\n\tcandidate described at "+errs.fileLine+":\n"
+					matchingErrs += "\n\tcandidate described at " + errs.fileLine + ":\n"
 					for _, err := range errs.errs {
 						matchingErrs += "\n\t\t" + err
 					}
@@ -343,7 +353,7 @@ func (d DifferentNameMockDescriptor) done() func(t interface{ Errorf(s string, a
 			if len(matching) == 0 {
 				matchingErrs := ""
 				for _, errs := range allErrs {
-					matchingErrs += "\n\tcandidate described at "+errs.fileLine+":\n"
+					matchingErrs += "\n\tcandidate described at " + errs.fileLine + ":\n"
 					for _, err := range errs.errs {
 						matchingErrs += "\n\t\t" + err
 					}
@@ -361,7 +371,9 @@ func (d DifferentNameMockDescriptor) done() func(t interface{ Errorf(s string, a
 			panic("unexpected call to mock for DifferentName.StdSomething")
 		}
 	}
-	return func(t interface{ Errorf(s string, args ...interface{})  }) bool {
+	return func(t interface {
+		Errorf(s string, args ...interface{})
+	}) bool {
 		ok := true
 		for _, assert := range atAssert {
 			method, errs := assert()
@@ -373,38 +385,23 @@ func (d DifferentNameMockDescriptor) done() func(t interface{ Errorf(s string, a
 		return ok
 	}
 }
-	
-// Boring starts describing a way method DifferentName.Boring is expected to be called
-// and what it should return.
-//
-// You can call it several times to describe different behaviors, each matching different parameters.
-func (d DifferentNameMockDescriptor) Boring() *DifferentNameBoringMockDescriptor {
-	return d.newDifferentNameBoringMockDescriptor()
-}
 
-func (d DifferentNameMockDescriptor) newDifferentNameBoringMockDescriptor() *DifferentNameBoringMockDescriptor {
-	_, file, line, _ := runtime.Caller(2)
-	return &DifferentNameBoringMockDescriptor{
-		mockDesc: d,
-		times: func(int) error { return nil },
-		argValidator: func() []string { return nil },
-		fileLine: fmt.Sprintf("%s:%d", file, line),
-	}
+// DifferentNameMockDescribedCall is the last step in the description of a way that a
+// method of DifferentName is to behave when called, with all expected parameters
+// and the resulting values specified.
+//
+// It has methods to describe the times the method is expected to be called.
+// Otherwise, through the embedded DifferentNameMockDescriptor, you can start
+// another method call description, or you can call Mock to end the description
+// and get the resulting mock.
+type DifferentNameMockDescribedCall struct {
+	DifferentNameMockDescriptor
+	times *func(int) error
 }
 
-// DifferentNameBoringMockDescriptor is returned by DifferentNameMockDescriptor.Boring and
-// holds methods to describe the mock for method DifferentName.Boring.
-type DifferentNameBoringMockDescriptor struct {
-	mockDesc DifferentNameMockDescriptor
-	times func(int) error
-	argValidator func() []string
-	call func()
-	fileLine string
-}
-	
 // Times lets you specify a exact number of times this method is expected to be
 // called.
-func (d *DifferentNameBoringMockDescriptor) Times(times int) DifferentNameMockDescriptor {
+func (d DifferentNameMockDescribedCall) Times(times int) DifferentNameMockDescriptor {
 	return d.TimesMatching(func(got int) error {
 		if got != times {
 			return fmt.Errorf("expected exactly %d calls, got %d", times, got)
@@ -415,7 +412,7 @@ func (d *DifferentNameBoringMockDescriptor) Times(times int) DifferentNameMockDe
 
 // AtLeastTimes lets you specify a minimum number of times this method is expected to be
 // called.
-func (d *DifferentNameBoringMockDescriptor) AtLeastTimes(times int) DifferentNameMockDescriptor {
+func (d DifferentNameMockDescribedCall) AtLeastTimes(times int) DifferentNameMockDescriptor {
 	return d.TimesMatching(func(got int) error {
 		if got < times {
 			return fmt.Errorf("expected at least %d calls, got %d", times, got)
@@ -426,188 +423,91 @@ func (d *DifferentNameBoringMockDescriptor) AtLeastTimes(times int) DifferentNam
 
 // TimesMatching lets you pass a function to accept or reject the number of times
 // this method has been called.
-func (d *DifferentNameBoringMockDescriptor) TimesMatching(f func(times int) error) DifferentNameMockDescriptor {
-	d.times = f
-	d.done()
-	return d.mockDesc
+func (d DifferentNameMockDescribedCall) TimesMatching(f func(times int) error) DifferentNameMockDescriptor {
+	*d.times = f
+	return d.DifferentNameMockDescriptor
 }
 
-// Mock finishes the description and produces a mock.
-//
-// See DifferentNameMockDescriptor.Mock for details.
-func (d *DifferentNameBoringMockDescriptor) Mock() (m DifferentNameMock, assert func(t interface{ Errorf(string, ...interface{})  }) (ok bool)) {
-	d.done()
-	return d.mockDesc.Mock()
-}
-	
-// Boring finishes the current description for method DifferentName.Boring and
-// starts describing for method Boring.
+// Boring starts describing a way method DifferentName.Boring is expected to be called
+// and what it should return.
 //
-// See DifferentNameMockDescriptor.Boring for details.
-func (d *DifferentNameBoringMockDescriptor) Boring() *DifferentNameBoringMockDescriptor {
-	d.done()
-	return d.mockDesc.newDifferentNameBoringMockDescriptor()
-}
-	
-// EmbeddedMethod finishes the current description for method DifferentName.Boring and
-// starts describing for method EmbeddedMethod.
+// You can call it several times to describe different behaviors, each matching different parameters.
 //
-// See DifferentNameMockDescriptor.EmbeddedMethod for details.
-func (d *DifferentNameBoringMockDescriptor) EmbeddedMethod() *DifferentNameEmbeddedMethodMockDescriptor {
-	d.done()
-	return d.mockDesc.newDifferentNameEmbeddedMethodMockDescriptor()
-}
-	
-// ReturnSomethingAtLeast finishes the current description for method DifferentName.Boring and
-// starts describing for method ReturnSomethingAtLeast.
+// MyInterface.Boring is documented as follows.
 //
-// See DifferentNameMockDescriptor.ReturnSomethingAtLeast for details.
-func (d *DifferentNameBoringMockDescriptor) ReturnSomethingAtLeast() *DifferentNameReturnSomethingAtLeastMockDescriptor {
-	d.done()
-	return d.mockDesc.newDifferentNameReturnSomethingAtLeastMockDescriptor()
+// Boring takes nothing and returns nothing.
+func (d DifferentNameMockDescriptor) Boring() DifferentNameMockDescribedCall {
+	return d.newDifferentNameBoringMockDescriptor().done()
 }
-	
-// ShouldBeFun finishes the current description for method DifferentName.Boring and
-// starts describing for method ShouldBeFun.
-//
-// See DifferentNameMockDescriptor.ShouldBeFun for details.
-func (d *DifferentNameBoringMockDescriptor) ShouldBeFun() *DifferentNameShouldBeFunMockDescriptor {
-	d.done()
-	return d.mockDesc.newDifferentNameShouldBeFunMockDescriptor()
+
+func (d DifferentNameMockDescriptor) newDifferentNameBoringMockDescriptor() *DifferentNameBoringMockDescriptor {
+	_, file, line, _ := runtime.Caller(2)
+	return &DifferentNameBoringMockDescriptor{
+		mockDesc:     d,
+		times:        func(int) error { return nil },
+		argValidator: func() []string { return nil },
+		fileLine:     fmt.Sprintf("%s:%d", file, line),
+	}
 }
-	
-// StdSomething finishes the current description for method DifferentName.Boring and
-// starts describing for method StdSomething.
-//
-// See DifferentNameMockDescriptor.StdSomething for details.
-func (d *DifferentNameBoringMockDescriptor) StdSomething() *DifferentNameStdSomethingMockDescriptor {
-	d.done()
-	return d.mockDesc.newDifferentNameStdSomethingMockDescriptor()
+
+// DifferentNameBoringMockDescriptor is returned by DifferentNameMockDescriptor.Boring and
+// holds methods to describe the mock for method DifferentName.Boring.
+type DifferentNameBoringMockDescriptor struct {
+	mockDesc     DifferentNameMockDescriptor
+	times        func(int) error
+	argValidator func() []string
+	call         func()
+	fileLine     string
 }
-	
-func (d *DifferentNameBoringMockDescriptor) done() {
+
+// done ends the description, adding it to the mock descriptor.
+func (d *DifferentNameBoringMockDescriptor) done() DifferentNameMockDescribedCall {
 	d.mockDesc.descriptors_Boring = append(d.mockDesc.descriptors_Boring, d)
+	return DifferentNameMockDescribedCall{d.mockDesc, &d.times}
 }
-	
+
 // EmbeddedMethod starts describing a way method DifferentName.EmbeddedMethod is expected to be called
 // and what it should return.
 //
 // You can call it several times to describe different behaviors, each matching different parameters.
-func (d DifferentNameMockDescriptor) EmbeddedMethod() *DifferentNameEmbeddedMethodMockDescriptor {
-	return d.newDifferentNameEmbeddedMethodMockDescriptor()
+func (d DifferentNameMockDescriptor) EmbeddedMethod() DifferentNameMockDescribedCall {
+	return d.newDifferentNameEmbeddedMethodMockDescriptor().done()
 }
 
 func (d DifferentNameMockDescriptor) newDifferentNameEmbeddedMethodMockDescriptor() *DifferentNameEmbeddedMethodMockDescriptor {
 	_, file, line, _ := runtime.Caller(2)
 	return &DifferentNameEmbeddedMethodMockDescriptor{
-		mockDesc: d,
-		times: func(int) error { return nil },
+		mockDesc:     d,
+		times:        func(int) error { return nil },
 		argValidator: func() []string { return nil },
-		fileLine: fmt.Sprintf("%s:%d", file, line),
+		fileLine:     fmt.Sprintf("%s:%d", file, line),
 	}
 }
 
 // DifferentNameEmbeddedMethodMockDescriptor is returned by DifferentNameMockDescriptor.EmbeddedMethod and
 // holds methods to describe the mock for method DifferentName.EmbeddedMethod.
 type DifferentNameEmbeddedMethodMockDescriptor struct {
-	mockDesc DifferentNameMockDescriptor
-	times func(int) error
+	mockDesc     DifferentNameMockDescriptor
+	times        func(int) error
 	argValidator func() []string
-	call func()
-	fileLine string
-}
-	
-// Times lets you specify a exact number of times this method is expected to be
-// called.
-func (d *DifferentNameEmbeddedMethodMockDescriptor) Times(times int) DifferentNameMockDescriptor {
-	return d.TimesMatching(func(got int) error {
-		if got != times {
-			return fmt.Errorf("expected exactly %d calls, got %d", times, got)
-		}
-		return nil
-	})
-}
-
-// AtLeastTimes lets you specify a minimum number of times this method is expected to be
-// called.
-func (d *DifferentNameEmbeddedMethodMockDescriptor) AtLeastTimes(times int) DifferentNameMockDescriptor {
-	return d.TimesMatching(func(got int) error {
-		if got < times {
-			return fmt.Errorf("expected at least %d calls, got %d", times, got)
-		}
-		return nil
-	})
+	call         func()
+	fileLine     string
 }
 
-// TimesMatching lets you pass a function to accept or reject the number of times
-// this method has been called.
-func (d *DifferentNameEmbeddedMethodMockDescriptor) TimesMatching(f func(times int) error) DifferentNameMockDescriptor {
-	d.times = f
-	d.done()
-	return d.mockDesc
-}
-
-// Mock finishes the description and produces a mock.
-//
-// See DifferentNameMockDescriptor.Mock for details.
-func (d *DifferentNameEmbeddedMethodMockDescriptor) Mock() (m DifferentNameMock, assert func(t interface{ Errorf(string, ...interface{})  }) (ok bool)) {
-	d.done()
-	return d.mockDesc.Mock()
-}
-	
-// Boring finishes the current description for method DifferentName.EmbeddedMethod and
-// starts describing for method Boring.
-//
-// See DifferentNameMockDescriptor.Boring for details.
-func (d *DifferentNameEmbeddedMethodMockDescriptor) Boring() *DifferentNameBoringMockDescriptor {
-	d.done()
-	return d.mockDesc.newDifferentNameBoringMockDescriptor()
-}
-	
-// EmbeddedMethod finishes the current description for method DifferentName.EmbeddedMethod and
-// starts describing for method EmbeddedMethod.
-//
-// See DifferentNameMockDescriptor.EmbeddedMethod for details.
-func (d *DifferentNameEmbeddedMethodMockDescriptor) EmbeddedMethod() *DifferentNameEmbeddedMethodMockDescriptor {
-	d.done()
-	return d.mockDesc.newDifferentNameEmbeddedMethodMockDescriptor()
-}
-	
-// ReturnSomethingAtLeast finishes the current description for method DifferentName.EmbeddedMethod and
-// starts describing for method ReturnSomethingAtLeast.
-//
-// See DifferentNameMockDescriptor.ReturnSomethingAtLeast for details.
-func (d *DifferentNameEmbeddedMethodMockDescriptor) ReturnSomethingAtLeast() *DifferentNameReturnSomethingAtLeastMockDescriptor {
-	d.done()
-	return d.mockDesc.newDifferentNameReturnSomethingAtLeastMockDescriptor()
-}
-	
-// ShouldBeFun finishes the current description for method DifferentName.EmbeddedMethod and
-// starts describing for method ShouldBeFun.
-//
-// See DifferentNameMockDescriptor.ShouldBeFun for details.
-func (d *DifferentNameEmbeddedMethodMockDescriptor) ShouldBeFun() *DifferentNameShouldBeFunMockDescriptor {
-	d.done()
-	return d.mockDesc.newDifferentNameShouldBeFunMockDescriptor()
-}
-	
-// StdSomething finishes the current description for method DifferentName.EmbeddedMethod and
-// starts describing for method StdSomething.
-//
-// See DifferentNameMockDescriptor.StdSomething for details.
-func (d *DifferentNameEmbeddedMethodMockDescriptor) StdSomething() *DifferentNameStdSomethingMockDescriptor {
-	d.done()
-	return d.mockDesc.newDifferentNameStdSomethingMockDescriptor()
-}
-	
-func (d *DifferentNameEmbeddedMethodMockDescriptor) done() {
+// done ends the description, adding it to the mock descriptor.
+func (d *DifferentNameEmbeddedMethodMockDescriptor) done() DifferentNameMockDescribedCall {
 	d.mockDesc.descriptors_EmbeddedMethod = append(d.mockDesc.descriptors_EmbeddedMethod, d)
+	return DifferentNameMockDescribedCall{d.mockDesc, &d.times}
 }
-	
+
 // ReturnSomethingAtLeast starts describing a way method DifferentName.ReturnSomethingAtLeast is expected to be called
 // and what it should return.
 //
 // You can call it several times to describe different behaviors, each matching different parameters.
+//
+// MyInterface.ReturnSomethingAtLeast is documented as follows.
+//
+// ReturnSomethingAtLeast returns an int, which is something at least.
 func (d DifferentNameMockDescriptor) ReturnSomethingAtLeast() *DifferentNameReturnSomethingAtLeastMockDescriptor {
 	return d.newDifferentNameReturnSomethingAtLeastMockDescriptor()
 }
@@ -615,143 +515,55 @@ func (d DifferentNameMockDescriptor) ReturnSomethingAtLeast() *DifferentNameRetu
 func (d DifferentNameMockDescriptor) newDifferentNameReturnSomethingAtLeastMockDescriptor() *DifferentNameReturnSomethingAtLeastMockDescriptor {
 	_, file, line, _ := runtime.Caller(2)
 	return &DifferentNameReturnSomethingAtLeastMockDescriptor{
-		mockDesc: d,
-		times: func(int) error { return nil },
+		mockDesc:     d,
+		times:        func(int) error { return nil },
 		argValidator: func() []string { return nil },
-		fileLine: fmt.Sprintf("%s:%d", file, line),
+		fileLine:     fmt.Sprintf("%s:%d", file, line),
 	}
 }
 
 // DifferentNameReturnSomethingAtLeastMockDescriptor is returned by DifferentNameMockDescriptor.ReturnSomethingAtLeast and
 // holds methods to describe the mock for method DifferentName.ReturnSomethingAtLeast.
 type DifferentNameReturnSomethingAtLeastMockDescriptor struct {
-	mockDesc DifferentNameMockDescriptor
-	times func(int) error
+	mockDesc     DifferentNameMockDescriptor
+	times        func(int) error
 	argValidator func() []string
-	call func() (r0 int)
-	fileLine string
+	call         func() (r0 int)
+	fileLine     string
 }
-	
+
 // Returns lets you specify the values that the mocked method DifferentName.ReturnSomethingAtLeast,
 // if called with values matching the expectations, will return.
-func (d *DifferentNameReturnSomethingAtLeastMockDescriptor) Returns(r0 int) DifferentNameReturnSomethingAtLeastMockDescriptorWithReturn {
+func (d *DifferentNameReturnSomethingAtLeastMockDescriptor) Returns(r0 int) DifferentNameMockDescribedCall {
 	return d.ReturnsFrom(func() int {
 		return r0
 	})
 }
 
-// Returns lets you specify the values that the mocked method DifferentName.ReturnSomethingAtLeast,
+// ReturnsFrom lets you specify the values that the mocked method DifferentName.ReturnSomethingAtLeast,
 // if called with values matching the expectations, will return.
-// 
-// It passes such passed values to a function that then returns the return values. 
-func (d *DifferentNameReturnSomethingAtLeastMockDescriptor) ReturnsFrom(f func() (r0 int)) DifferentNameReturnSomethingAtLeastMockDescriptorWithReturn {
+//
+// It passes such passed values to a function that then returns the return values.
+func (d *DifferentNameReturnSomethingAtLeastMockDescriptor) ReturnsFrom(f func() (r0 int)) DifferentNameMockDescribedCall {
 	d.call = f
-	return DifferentNameReturnSomethingAtLeastMockDescriptorWithReturn{d}
-}
-
-// DifferentNameReturnSomethingAtLeastMockDescriptorWithReturn is a step forward in the description of a way that
-// method DifferentName.ReturnSomethingAtLeast is to behave when called, with all expected parameters
-// and the resulting values specified.
-// arguments specified.
-// 
-// It has methods to describe the times the method is expected to be called,
-// or you can start another method call description, or you can call Mock to
-// end the description and get the resulting mock.
-type DifferentNameReturnSomethingAtLeastMockDescriptorWithReturn struct {
-	methodDesc *DifferentNameReturnSomethingAtLeastMockDescriptor
-}
-	
-// Times lets you specify a exact number of times this method is expected to be
-// called.
-func (d DifferentNameReturnSomethingAtLeastMockDescriptorWithReturn) Times(times int) DifferentNameMockDescriptor {
-	return d.TimesMatching(func(got int) error {
-		if got != times {
-			return fmt.Errorf("expected exactly %d calls, got %d", times, got)
-		}
-		return nil
-	})
-}
-
-// AtLeastTimes lets you specify a minimum number of times this method is expected to be
-// called.
-func (d DifferentNameReturnSomethingAtLeastMockDescriptorWithReturn) AtLeastTimes(times int) DifferentNameMockDescriptor {
-	return d.TimesMatching(func(got int) error {
-		if got < times {
-			return fmt.Errorf("expected at least %d calls, got %d", times, got)
-		}
-		return nil
-	})
-}
-
-// TimesMatching lets you pass a function to accept or reject the number of times
-// this method has been called.
-func (d DifferentNameReturnSomethingAtLeastMockDescriptorWithReturn) TimesMatching(f func(times int) error) DifferentNameMockDescriptor {
-	d.methodDesc.times = f
-	d.methodDesc.done()
-	return d.methodDesc.mockDesc
+	return d.done()
 }
 
-// Mock finishes the description and produces a mock.
-//
-// See DifferentNameMockDescriptor.Mock for details.
-func (d DifferentNameReturnSomethingAtLeastMockDescriptorWithReturn) Mock() (m DifferentNameMock, assert func(t interface{ Errorf(string, ...interface{})  }) (ok bool)) {
-	d.methodDesc.done()
-	return d.methodDesc.mockDesc.Mock()
-}
-	
-// Boring finishes the current description for method DifferentName.ReturnSomethingAtLeast and
-// starts describing for method Boring.
-//
-// See DifferentNameMockDescriptor.Boring for details.
-func (d DifferentNameReturnSomethingAtLeastMockDescriptorWithReturn) Boring() *DifferentNameBoringMockDescriptor {
-	d.methodDesc.done()
-	return d.methodDesc.mockDesc.newDifferentNameBoringMockDescriptor()
-}
-	
-// EmbeddedMethod finishes the current description for method DifferentName.ReturnSomethingAtLeast and
-// starts describing for method EmbeddedMethod.
-//
-// See DifferentNameMockDescriptor.EmbeddedMethod for details.
-func (d DifferentNameReturnSomethingAtLeastMockDescriptorWithReturn) EmbeddedMethod() *DifferentNameEmbeddedMethodMockDescriptor {
-	d.methodDesc.done()
-	return d.methodDesc.mockDesc.newDifferentNameEmbeddedMethodMockDescriptor()
-}
-	
-// ReturnSomethingAtLeast finishes the current description for method DifferentName.ReturnSomethingAtLeast and
-// starts describing for method ReturnSomethingAtLeast.
-//
-// See DifferentNameMockDescriptor.ReturnSomethingAtLeast for details.
-func (d DifferentNameReturnSomethingAtLeastMockDescriptorWithReturn) ReturnSomethingAtLeast() *DifferentNameReturnSomethingAtLeastMockDescriptor {
-	d.methodDesc.done()
-	return d.methodDesc.mockDesc.newDifferentNameReturnSomethingAtLeastMockDescriptor()
-}
-	
-// ShouldBeFun finishes the current description for method DifferentName.ReturnSomethingAtLeast and
-// starts describing for method ShouldBeFun.
-//
-// See DifferentNameMockDescriptor.ShouldBeFun for details.
-func (d DifferentNameReturnSomethingAtLeastMockDescriptorWithReturn) ShouldBeFun() *DifferentNameShouldBeFunMockDescriptor {
-	d.methodDesc.done()
-	return d.methodDesc.mockDesc.newDifferentNameShouldBeFunMockDescriptor()
-}
-	
-// StdSomething finishes the current description for method DifferentName.ReturnSomethingAtLeast and
-// starts describing for method StdSomething.
-//
-// See DifferentNameMockDescriptor.StdSomething for details.
-func (d DifferentNameReturnSomethingAtLeastMockDescriptorWithReturn) StdSomething() *DifferentNameStdSomethingMockDescriptor {
-	d.methodDesc.done()
-	return d.methodDesc.mockDesc.newDifferentNameStdSomethingMockDescriptor()
-}
-	
-func (d *DifferentNameReturnSomethingAtLeastMockDescriptor) done() {
+// done ends the description, adding it to the mock descriptor.
+func (d *DifferentNameReturnSomethingAtLeastMockDescriptor) done() DifferentNameMockDescribedCall {
 	d.mockDesc.descriptors_ReturnSomethingAtLeast = append(d.mockDesc.descriptors_ReturnSomethingAtLeast, d)
+	return DifferentNameMockDescribedCall{d.mockDesc, &d.times}
 }
-	
+
 // ShouldBeFun starts describing a way method DifferentName.ShouldBeFun is expected to be called
 // and what it should return.
 //
 // You can call it several times to describe different behaviors, each matching different parameters.
+//
+// MyInterface.ShouldBeFun is documented as follows.
+//
+// ShouldBeFun takes some complex types, and a variadic argument. Its
+// parameters are unnamed, so they get synthetic names in the mock.
 func (d DifferentNameMockDescriptor) ShouldBeFun() *DifferentNameShouldBeFunMockDescriptor {
 	return d.newDifferentNameShouldBeFunMockDescriptor()
 }
@@ -760,8 +572,10 @@ func (d DifferentNameMockDescriptor) newDifferentNameShouldBeFunMockDescriptor()
 	_, file, line, _ := runtime.Caller(2)
 	return &DifferentNameShouldBeFunMockDescriptor{
 		mockDesc: d,
-		times: func(int) error { return nil },
-		argValidator: func(got_a0 int, got_a1 map[string]map[MyStruct]bool, got_a2 []chan<- <-chan struct{}) []string { return nil },
+		times:    func(int) error { return nil },
+		argValidator: func(got_a0 int, got_a1 map[string]map[MyStruct]bool, got_a2 []chan<- <-chan struct{}) []string {
+			return nil
+		},
 		fileLine: fmt.Sprintf("%s:%d", file, line),
 	}
 }
@@ -769,16 +583,16 @@ func (d DifferentNameMockDescriptor) newDifferentNameShouldBeFunMockDescriptor()
 // DifferentNameShouldBeFunMockDescriptor is returned by DifferentNameMockDescriptor.ShouldBeFun and
 // holds methods to describe the mock for method DifferentName.ShouldBeFun.
 type DifferentNameShouldBeFunMockDescriptor struct {
-	mockDesc DifferentNameMockDescriptor
-	times func(int) error
+	mockDesc     DifferentNameMockDescriptor
+	times        func(int) error
 	argValidator func(got_a0 int, got_a1 map[string]map[MyStruct]bool, got_a2 []chan<- <-chan struct{}) []string
-	call func(a0 int, a1 map[string]map[MyStruct]bool, a2 []chan<- <-chan struct{}) (r0 int, r1 error)
-	fileLine string
+	call         func(a0 int, a1 map[string]map[MyStruct]bool, a2 []chan<- <-chan struct{}) (r0 int, r1 error)
+	fileLine     string
 }
-	
+
 // Takes lets you specify a value with which the actual value passed to
 // the mocked method DifferentName.ShouldBeFun as parameter #1
-// will be compared. 
+// will be compared.
 //
 // Package "github.com/google/go-cmp/cmp" is used to do the comparison. You can
 // pass extra options for it.
@@ -791,7 +605,7 @@ func (d *DifferentNameShouldBeFunMockDescriptor) Takes(a0 int, opts ...cmp.Optio
 	d.argValidator = func(got_a0 int, got_a1 map[string]map[MyStruct]bool, got_a2 []chan<- <-chan struct{}) []string {
 		errMsgs := prev(got_a0, got_a1, got_a2)
 		if diff := cmp.Diff(a0, got_a0, opts...); diff != "" {
-			errMsgs = append(errMsgs, "parameter #1 mismatch:\n" + diff)
+			errMsgs = append(errMsgs, "parameter #1 mismatch:\n"+diff)
 		}
 		return errMsgs
 	}
@@ -811,7 +625,7 @@ func (d *DifferentNameShouldBeFunMockDescriptor) TakesMatching(match func(a0 int
 	d.argValidator = func(got_a0 int, got_a1 map[string]map[MyStruct]bool, got_a2 []chan<- <-chan struct{}) []string {
 		errMsgs := prev(got_a0, got_a1, got_a2)
 		if err := match(got_a0); err != nil {
-			errMsgs = append(errMsgs, "parameter \"a0\" custom matcher error: " + err.Error())
+			errMsgs = append(errMsgs, "parameter \"a0\" custom matcher error: "+err.Error())
 		}
 		return errMsgs
 	}
@@ -822,16 +636,15 @@ func (d *DifferentNameShouldBeFunMockDescriptor) TakesMatching(match func(a0 int
 // method DifferentName.ShouldBeFun is expected to be called, with 1
 // arguments specified.
 //
-// It has methods to describe the next argument, if there's
-// any left, or the return values, if there are any, or the times it's expected
-// to be called otherwise.
+// It has methods to describe the next argument, if there's any left, or the
+// return values otherwise.
 type DifferentNameShouldBeFunMockDescriptorWith1Arg struct {
 	methodDesc *DifferentNameShouldBeFunMockDescriptor
 }
-	
+
 // And lets you specify a value with which the actual value passed to
 // the mocked method DifferentName.ShouldBeFun as parameter #2
-// will be compared. 
+// will be compared.
 //
 // Package "github.com/google/go-cmp/cmp" is used to do the comparison. You can
 // pass extra options for it.
@@ -844,7 +657,7 @@ func (d DifferentNameShouldBeFunMockDescriptorWith1Arg) And(a1 map[string]map[My
 	d.methodDesc.argValidator = func(got_a0 int, got_a1 map[string]map[MyStruct]bool, got_a2 []chan<- <-chan struct{}) []string {
 		errMsgs := prev(got_a0, got_a1, got_a2)
 		if diff := cmp.Diff(a1, got_a1, opts...); diff != "" {
-			errMsgs = append(errMsgs, "parameter #2 mismatch:\n" + diff)
+			errMsgs = append(errMsgs, "parameter #2 mismatch:\n"+diff)
 		}
 		return errMsgs
 	}
@@ -864,7 +677,7 @@ func (d DifferentNameShouldBeFunMockDescriptorWith1Arg) AndMatching(match func(a
 	d.methodDesc.argValidator = func(got_a0 int, got_a1 map[string]map[MyStruct]bool, got_a2 []chan<- <-chan struct{}) []string {
 		errMsgs := prev(got_a0, got_a1, got_a2)
 		if err := match(got_a1); err != nil {
-			errMsgs = append(errMsgs, "parameter \"a1\" custom matcher error: " + err.Error())
+			errMsgs = append(errMsgs, "parameter \"a1\" custom matcher error: "+err.Error())
 		}
 		return errMsgs
 	}
@@ -875,16 +688,15 @@ func (d DifferentNameShouldBeFunMockDescriptorWith1Arg) AndMatching(match func(a
 // method DifferentName.ShouldBeFun is expected to be called, with 2
 // arguments specified.
 //
-// It has methods to describe the next argument, if there's
-// any left, or the return values, if there are any, or the times it's expected
-// to be called otherwise.
+// It has methods to describe the next argument, if there's any left, or the
+// return values otherwise.
 type DifferentNameShouldBeFunMockDescriptorWith2Args struct {
 	methodDesc *DifferentNameShouldBeFunMockDescriptor
 }
-	
+
 // And lets you specify a value with which the actual value passed to
 // the mocked method DifferentName.ShouldBeFun as parameter #3
-// will be compared. 
+// will be compared.
 //
 // Package "github.com/google/go-cmp/cmp" is used to do the comparison. You can
 // pass extra options for it.
@@ -897,7 +709,7 @@ func (d DifferentNameShouldBeFunMockDescriptorWith2Args) And(a2 []chan<- <-chan
 	d.methodDesc.argValidator = func(got_a0 int, got_a1 map[string]map[MyStruct]bool, got_a2 []chan<- <-chan struct{}) []string {
 		errMsgs := prev(got_a0, got_a1, got_a2)
 		if diff := cmp.Diff(a2, got_a2, opts...); diff != "" {
-			errMsgs = append(errMsgs, "parameter #3 mismatch:\n" + diff)
+			errMsgs = append(errMsgs, "parameter #3 mismatch:\n"+diff)
 		}
 		return errMsgs
 	}
@@ -917,7 +729,7 @@ func (d DifferentNameShouldBeFunMockDescriptorWith2Args) AndMatching(match func(
 	d.methodDesc.argValidator = func(got_a0 int, got_a1 map[string]map[MyStruct]bool, got_a2 []chan<- <-chan struct{}) []string {
 		errMsgs := prev(got_a0, got_a1, got_a2)
 		if err := match(got_a2); err != nil {
-			errMsgs = append(errMsgs, "parameter \"a2\" custom matcher error: " + err.Error())
+			errMsgs = append(errMsgs, "parameter \"a2\" custom matcher error: "+err.Error())
 		}
 		return errMsgs
 	}
@@ -928,133 +740,43 @@ func (d DifferentNameShouldBeFunMockDescriptorWith2Args) AndMatching(match func(
 // method DifferentName.ShouldBeFun is expected to be called, with 3
 // arguments specified.
 //
-// It has methods to describe the next argument, if there's
-// any left, or the return values, if there are any, or the times it's expected
-// to be called otherwise.
+// It has methods to describe the next argument, if there's any left, or the
+// return values otherwise.
 type DifferentNameShouldBeFunMockDescriptorWith3Args struct {
 	methodDesc *DifferentNameShouldBeFunMockDescriptor
 }
-	
+
 // Returns lets you specify the values that the mocked method DifferentName.ShouldBeFun,
 // if called with values matching the expectations, will return.
-func (d DifferentNameShouldBeFunMockDescriptorWith3Args) Returns(r0 int, r1 error) DifferentNameShouldBeFunMockDescriptorWithReturn {
+func (d DifferentNameShouldBeFunMockDescriptorWith3Args) Returns(r0 int, r1 error) DifferentNameMockDescribedCall {
 	return d.ReturnsFrom(func(int, map[string]map[MyStruct]bool, []chan<- <-chan struct{}) (int, error) {
 		return r0, r1
 	})
 }
 
-// Returns lets you specify the values that the mocked method DifferentName.ShouldBeFun,
+// ReturnsFrom lets you specify the values that the mocked method DifferentName.ShouldBeFun,
 // if called with values matching the expectations, will return.
-// 
-// It passes such passed values to a function that then returns the return values. 
-func (d DifferentNameShouldBeFunMockDescriptorWith3Args) ReturnsFrom(f func(a0 int, a1 map[string]map[MyStruct]bool, a2 []chan<- <-chan struct{}) (r0 int, r1 error)) DifferentNameShouldBeFunMockDescriptorWithReturn {
+//
+// It passes such passed values to a function that then returns the return values.
+func (d DifferentNameShouldBeFunMockDescriptorWith3Args) ReturnsFrom(f func(a0 int, a1 map[string]map[MyStruct]bool, a2 []chan<- <-chan struct{}) (r0 int, r1 error)) DifferentNameMockDescribedCall {
 	d.methodDesc.call = f
-	return DifferentNameShouldBeFunMockDescriptorWithReturn{d.methodDesc}
+	return d.methodDesc.done()
 }
 
-// DifferentNameShouldBeFunMockDescriptorWithReturn is a step forward in the description of a way that
-// method DifferentName.ShouldBeFun is to behave when called, with all expected parameters
-// and the resulting values specified.
-// arguments specified.
-// 
-// It has methods to describe the times the method is expected to be called,
-// or you can start another method call description, or you can call Mock to
-// end the description and get the resulting mock.
-type DifferentNameShouldBeFunMockDescriptorWithReturn struct {
-	methodDesc *DifferentNameShouldBeFunMockDescriptor
-}
-	
-// Times lets you specify a exact number of times this method is expected to be
-// called.
-func (d DifferentNameShouldBeFunMockDescriptorWithReturn) Times(times int) DifferentNameMockDescriptor {
-	return d.TimesMatching(func(got int) error {
-		if got != times {
-			return fmt.Errorf("expected exactly %d calls, got %d", times, got)
-		}
-		return nil
-	})
-}
-
-// AtLeastTimes lets you specify a minimum number of times this method is expected to be
-// called.
-func (d DifferentNameShouldBeFunMockDescriptorWithReturn) AtLeastTimes(times int) DifferentNameMockDescriptor {
-	return d.TimesMatching(func(got int) error {
-		if got < times {
-			return fmt.Errorf("expected at least %d calls, got %d", times, got)
-		}
-		return nil
-	})
-}
-
-// TimesMatching lets you pass a function to accept or reject the number of times
-// this method has been called.
-func (d DifferentNameShouldBeFunMockDescriptorWithReturn) TimesMatching(f func(times int) error) DifferentNameMockDescriptor {
-	d.methodDesc.times = f
-	d.methodDesc.done()
-	return d.methodDesc.mockDesc
-}
-
-// Mock finishes the description and produces a mock.
-//
-// See DifferentNameMockDescriptor.Mock for details.
-func (d DifferentNameShouldBeFunMockDescriptorWithReturn) Mock() (m DifferentNameMock, assert func(t interface{ Errorf(string, ...interface{})  }) (ok bool)) {
-	d.methodDesc.done()
-	return d.methodDesc.mockDesc.Mock()
-}
-	
-// Boring finishes the current description for method DifferentName.ShouldBeFun and
-// starts describing for method Boring.
-//
-// See DifferentNameMockDescriptor.Boring for details.
-func (d DifferentNameShouldBeFunMockDescriptorWithReturn) Boring() *DifferentNameBoringMockDescriptor {
-	d.methodDesc.done()
-	return d.methodDesc.mockDesc.newDifferentNameBoringMockDescriptor()
-}
-	
-// EmbeddedMethod finishes the current description for method DifferentName.ShouldBeFun and
-// starts describing for method EmbeddedMethod.
-//
-// See DifferentNameMockDescriptor.EmbeddedMethod for details.
-func (d DifferentNameShouldBeFunMockDescriptorWithReturn) EmbeddedMethod() *DifferentNameEmbeddedMethodMockDescriptor {
-	d.methodDesc.done()
-	return d.methodDesc.mockDesc.newDifferentNameEmbeddedMethodMockDescriptor()
-}
-	
-// ReturnSomethingAtLeast finishes the current description for method DifferentName.ShouldBeFun and
-// starts describing for method ReturnSomethingAtLeast.
-//
-// See DifferentNameMockDescriptor.ReturnSomethingAtLeast for details.
-func (d DifferentNameShouldBeFunMockDescriptorWithReturn) ReturnSomethingAtLeast() *DifferentNameReturnSomethingAtLeastMockDescriptor {
-	d.methodDesc.done()
-	return d.methodDesc.mockDesc.newDifferentNameReturnSomethingAtLeastMockDescriptor()
-}
-	
-// ShouldBeFun finishes the current description for method DifferentName.ShouldBeFun and
-// starts describing for method ShouldBeFun.
-//
-// See DifferentNameMockDescriptor.ShouldBeFun for details.
-func (d DifferentNameShouldBeFunMockDescriptorWithReturn) ShouldBeFun() *DifferentNameShouldBeFunMockDescriptor {
-	d.methodDesc.done()
-	return d.methodDesc.mockDesc.newDifferentNameShouldBeFunMockDescriptor()
-}
-	
-// StdSomething finishes the current description for method DifferentName.ShouldBeFun and
-// starts describing for method StdSomething.
-//
-// See DifferentNameMockDescriptor.StdSomething for details.
-func (d DifferentNameShouldBeFunMockDescriptorWithReturn) StdSomething() *DifferentNameStdSomethingMockDescriptor {
-	d.methodDesc.done()
-	return d.methodDesc.mockDesc.newDifferentNameStdSomethingMockDescriptor()
-}
-	
-func (d *DifferentNameShouldBeFunMockDescriptor) done() {
+// done ends the description, adding it to the mock descriptor.
+func (d *DifferentNameShouldBeFunMockDescriptor) done() DifferentNameMockDescribedCall {
 	d.mockDesc.descriptors_ShouldBeFun = append(d.mockDesc.descriptors_ShouldBeFun, d)
+	return DifferentNameMockDescribedCall{d.mockDesc, &d.times}
 }
-	
+
 // StdSomething starts describing a way method DifferentName.StdSomething is expected to be called
 // and what it should return.
 //
 // You can call it several times to describe different behaviors, each matching different parameters.
+//
+// MyInterface.StdSomething is documented as follows.
+//
+// StdSomething takes a type from the standard library.
 func (d DifferentNameMockDescriptor) StdSomething() *DifferentNameStdSomethingMockDescriptor {
 	return d.newDifferentNameStdSomethingMockDescriptor()
 }
@@ -1062,26 +784,26 @@ func (d DifferentNameMockDescriptor) StdSomething() *DifferentNameStdSomethingMo
 func (d DifferentNameMockDescriptor) newDifferentNameStdSomethingMockDescriptor() *DifferentNameStdSomethingMockDescriptor {
 	_, file, line, _ := runtime.Caller(2)
 	return &DifferentNameStdSomethingMockDescriptor{
-		mockDesc: d,
-		times: func(int) error { return nil },
+		mockDesc:     d,
+		times:        func(int) error { return nil },
 		argValidator: func(got_f *os.File, got_ints []int) []string { return nil },
-		fileLine: fmt.Sprintf("%s:%d", file, line),
+		fileLine:     fmt.Sprintf("%s:%d", file, line),
 	}
 }
 
 // DifferentNameStdSomethingMockDescriptor is returned by DifferentNameMockDescriptor.StdSomething and
 // holds methods to describe the mock for method DifferentName.StdSomething.
 type DifferentNameStdSomethingMockDescriptor struct {
-	mockDesc DifferentNameMockDescriptor
-	times func(int) error
+	mockDesc     DifferentNameMockDescriptor
+	times        func(int) error
 	argValidator func(got_f *os.File, got_ints []int) []string
-	call func(f *os.File, ints []int) (named bool)
-	fileLine string
+	call         func(f *os.File, ints []int) (named bool)
+	fileLine     string
 }
-	
+
 // Takes lets you specify a value with which the actual value passed to
 // the mocked method DifferentName.StdSomething as parameter #1
-// will be compared. 
+// will be compared.
 //
 // Package "github.com/google/go-cmp/cmp" is used to do the comparison. You can
 // pass extra options for it.
@@ -1094,7 +816,7 @@ func (d *DifferentNameStdSomethingMockDescriptor) Takes(f *os.File, opts ...cmp.
 	d.argValidator = func(got_f *os.File, got_ints []int) []string {
 		errMsgs := prev(got_f, got_ints)
 		if diff := cmp.Diff(f, got_f, opts...); diff != "" {
-			errMsgs = append(errMsgs, "parameter #1 mismatch:\n" + diff)
+			errMsgs = append(errMsgs, "parameter #1 mismatch:\n"+diff)
 		}
 		return errMsgs
 	}
@@ -1114,7 +836,7 @@ func (d *DifferentNameStdSomethingMockDescriptor) TakesMatching(match func(f *os
 	d.argValidator = func(got_f *os.File, got_ints []int) []string {
 		errMsgs := prev(got_f, got_ints)
 		if err := match(got_f); err != nil {
-			errMsgs = append(errMsgs, "parameter \"f\" custom matcher error: " + err.Error())
+			errMsgs = append(errMsgs, "parameter \"f\" custom matcher error: "+err.Error())
 		}
 		return errMsgs
 	}
@@ -1125,16 +847,15 @@ func (d *DifferentNameStdSomethingMockDescriptor) TakesMatching(match func(f *os
 // method DifferentName.StdSomething is expected to be called, with 1
 // arguments specified.
 //
-// It has methods to describe the next argument, if there's
-// any left, or the return values, if there are any, or the times it's expected
-// to be called otherwise.
+// It has methods to describe the next argument, if there's any left, or the
+// return values otherwise.
 type DifferentNameStdSomethingMockDescriptorWith1Arg struct {
 	methodDesc *DifferentNameStdSomethingMockDescriptor
 }
-	
+
 // And lets you specify a value with which the actual value passed to
 // the mocked method DifferentName.StdSomething as parameter #2
-// will be compared. 
+// will be compared.
 //
 // Package "github.com/google/go-cmp/cmp" is used to do the comparison. You can
 // pass extra options for it.
@@ -1147,7 +868,7 @@ func (d DifferentNameStdSomethingMockDescriptorWith1Arg) And(ints []int, opts ..
 	d.methodDesc.argValidator = func(got_f *os.File, got_ints []int) []string {
 		errMsgs := prev(got_f, got_ints)
 		if diff := cmp.Diff(ints, got_ints, opts...); diff != "" {
-			errMsgs = append(errMsgs, "parameter #2 mismatch:\n" + diff)
+			errMsgs = append(errMsgs, "parameter #2 mismatch:\n"+diff)
 		}
 		return errMsgs
 	}
@@ -1167,7 +888,7 @@ func (d DifferentNameStdSomethingMockDescriptorWith1Arg) AndMatching(match func(
 	d.methodDesc.argValidator = func(got_f *os.File, got_ints []int) []string {
 		errMsgs := prev(got_f, got_ints)
 		if err := match(got_ints); err != nil {
-			errMsgs = append(errMsgs, "parameter \"ints\" custom matcher error: " + err.Error())
+			errMsgs = append(errMsgs, "parameter \"ints\" custom matcher error: "+err.Error())
 		}
 		return errMsgs
 	}
@@ -1178,129 +899,35 @@ func (d DifferentNameStdSomethingMockDescriptorWith1Arg) AndMatching(match func(
 // method DifferentName.StdSomething is expected to be called, with 2
 // arguments specified.
 //
-// It has methods to describe the next argument, if there's
-// any left, or the return values, if there are any, or the times it's expected
-// to be called otherwise.
+// It has methods to describe the next argument, if there's any left, or the
+// return values otherwise.
 type DifferentNameStdSomethingMockDescriptorWith2Args struct {
 	methodDesc *DifferentNameStdSomethingMockDescriptor
 }
-	
+
 // Returns lets you specify the values that the mocked method DifferentName.StdSomething,
 // if called with values matching the expectations, will return.
-func (d DifferentNameStdSomethingMockDescriptorWith2Args) Returns(named bool) DifferentNameStdSomethingMockDescriptorWithReturn {
+func (d DifferentNameStdSomethingMockDescriptorWith2Args) Returns(named bool) DifferentNameMockDescribedCall {
 	return d.ReturnsFrom(func(*os.File, []int) bool {
 		return named
 	})
 }
 
-// Returns lets you specify the values that the mocked method DifferentName.StdSomething,
+// ReturnsFrom lets you specify the values that the mocked method DifferentName.StdSomething,
 // if called with values matching the expectations, will return.
-// 
-// It passes such passed values to a function that then returns the return values. 
-func (d DifferentNameStdSomethingMockDescriptorWith2Args) ReturnsFrom(f func(f *os.File, ints []int) (named bool)) DifferentNameStdSomethingMockDescriptorWithReturn {
+//
+// It passes such passed values to a function that then returns the return values.
+func (d DifferentNameStdSomethingMockDescriptorWith2Args) ReturnsFrom(f func(f *os.File, ints []int) (named bool)) DifferentNameMockDescribedCall {
 	d.methodDesc.call = f
-	return DifferentNameStdSomethingMockDescriptorWithReturn{d.methodDesc}
+	return d.methodDesc.done()
 }
 
-// DifferentNameStdSomethingMockDescriptorWithReturn is a step forward in the description of a way that
-// method DifferentName.StdSomething is to behave when called, with all expected parameters
-// and the resulting values specified.
-// arguments specified.
-// 
-// It has methods to describe the times the method is expected to be called,
-// or you can start another method call description, or you can call Mock to
-// end the description and get the resulting mock.
-type DifferentNameStdSomethingMockDescriptorWithReturn struct {
-	methodDesc *DifferentNameStdSomethingMockDescriptor
-}
-	
-// Times lets you specify a exact number of times this method is expected to be
-// called.
-func (d DifferentNameStdSomethingMockDescriptorWithReturn) Times(times int) DifferentNameMockDescriptor {
-	return d.TimesMatching(func(got int) error {
-		if got != times {
-			return fmt.Errorf("expected exactly %d calls, got %d", times, got)
-		}
-		return nil
-	})
-}
-
-// AtLeastTimes lets you specify a minimum number of times this method is expected to be
-// called.
-func (d DifferentNameStdSomethingMockDescriptorWithReturn) AtLeastTimes(times int) DifferentNameMockDescriptor {
-	return d.TimesMatching(func(got int) error {
-		if got < times {
-			return fmt.Errorf("expected at least %d calls, got %d", times, got)
-		}
-		return nil
-	})
-}
-
-// TimesMatching lets you pass a function to accept or reject the number of times
-// this method has been called.
-func (d DifferentNameStdSomethingMockDescriptorWithReturn) TimesMatching(f func(times int) error) DifferentNameMockDescriptor {
-	d.methodDesc.times = f
-	d.methodDesc.done()
-	return d.methodDesc.mockDesc
-}
-
-// Mock finishes the description and produces a mock.
-//
-// See DifferentNameMockDescriptor.Mock for details.
-func (d DifferentNameStdSomethingMockDescriptorWithReturn) Mock() (m DifferentNameMock, assert func(t interface{ Errorf(string, ...interface{})  }) (ok bool)) {
-	d.methodDesc.done()
-	return d.methodDesc.mockDesc.Mock()
-}
-	
-// Boring finishes the current description for method DifferentName.StdSomething and
-// starts describing for method Boring.
-//
-// See DifferentNameMockDescriptor.Boring for details.
-func (d DifferentNameStdSomethingMockDescriptorWithReturn) Boring() *DifferentNameBoringMockDescriptor {
-	d.methodDesc.done()
-	return d.methodDesc.mockDesc.newDifferentNameBoringMockDescriptor()
-}
-	
-// EmbeddedMethod finishes the current description for method DifferentName.StdSomething and
-// starts describing for method EmbeddedMethod.
-//
-// See DifferentNameMockDescriptor.EmbeddedMethod for details.
-func (d DifferentNameStdSomethingMockDescriptorWithReturn) EmbeddedMethod() *DifferentNameEmbeddedMethodMockDescriptor {
-	d.methodDesc.done()
-	return d.methodDesc.mockDesc.newDifferentNameEmbeddedMethodMockDescriptor()
-}
-	
-// ReturnSomethingAtLeast finishes the current description for method DifferentName.StdSomething and
-// starts describing for method ReturnSomethingAtLeast.
-//
-// See DifferentNameMockDescriptor.ReturnSomethingAtLeast for details.
-func (d DifferentNameStdSomethingMockDescriptorWithReturn) ReturnSomethingAtLeast() *DifferentNameReturnSomethingAtLeastMockDescriptor {
-	d.methodDesc.done()
-	return d.methodDesc.mockDesc.newDifferentNameReturnSomethingAtLeastMockDescriptor()
-}
-	
-// ShouldBeFun finishes the current description for method DifferentName.StdSomething and
-// starts describing for method ShouldBeFun.
-//
-// See DifferentNameMockDescriptor.ShouldBeFun for details.
-func (d DifferentNameStdSomethingMockDescriptorWithReturn) ShouldBeFun() *DifferentNameShouldBeFunMockDescriptor {
-	d.methodDesc.done()
-	return d.methodDesc.mockDesc.newDifferentNameShouldBeFunMockDescriptor()
-}
-	
-// StdSomething finishes the current description for method DifferentName.StdSomething and
-// starts describing for method StdSomething.
-//
-// See DifferentNameMockDescriptor.StdSomething for details.
-func (d DifferentNameStdSomethingMockDescriptorWithReturn) StdSomething() *DifferentNameStdSomethingMockDescriptor {
-	d.methodDesc.done()
-	return d.methodDesc.mockDesc.newDifferentNameStdSomethingMockDescriptor()
-}
-	
-func (d *DifferentNameStdSomethingMockDescriptor) done() {
+// done ends the description, adding it to the mock descriptor.
+func (d *DifferentNameStdSomethingMockDescriptor) done() DifferentNameMockDescribedCall {
 	d.mockDesc.descriptors_StdSomething = append(d.mockDesc.descriptors_StdSomething, d)
+	return DifferentNameMockDescribedCall{d.mockDesc, &d.times}
 }
-	
+
 // Mock returns a mock for MyInterface that calls the functions
 // defined as struct fields in the receiver.
 //
@@ -1338,9 +965,14 @@ func (m _makegomock_DifferentNameMockFromMocker) StdSomething(f *os.File, ints .
 // It is copied from the original just to avoid introducing a dependency on
 // MyInterface's package.
 type DifferentNameMock interface {
+	// Boring takes nothing and returns nothing.
 	Boring()
 	EmbeddedMethod()
+	// ReturnSomethingAtLeast returns an int, which is something at least.
 	ReturnSomethingAtLeast() (r0 int)
+	// ShouldBeFun takes some complex types, and a variadic argument. Its
+	// parameters are unnamed, so they get synthetic names in the mock.
 	ShouldBeFun(a0 int, a1 map[string]map[MyStruct]bool, a2 ...chan<- <-chan struct{}) (r0 int, r1 error)
+	// StdSomething takes a type from the standard library.
 	StdSomething(f *os.File, ints ...int) (named bool)
 }
@@ -3,9 +3,10 @@
 package examples
 
 import (
-	fmt "fmt"
-	cmp "github.com/google/go-cmp/cmp"
-	runtime "runtime"
+	"fmt"
+	"runtime"
+
+	"github.com/google/go-cmp/cmp"
 )
 
 // KeyValuesRepositoryMocker builds mocks for type KeyValuesRepository.
@@ -38,7 +39,7 @@ func (m *KeyValuesRepositoryMocker) Describe() KeyValuesRepositoryMockDescriptor
 // When you're done describing methods, call its Mock method to get a mock that
 // implements the behavior you described.
 type KeyValuesRepositoryMockDescriptor struct {
-	m *KeyValuesRepositoryMocker
+	m               *KeyValuesRepositoryMocker
 	descriptors_Get []*KeyValuesRepositoryGetMockDescriptor
 	descriptors_Put []*KeyValuesRepositoryPutMockDescriptor
 }
@@ -49,18 +50,22 @@ type KeyValuesRepositoryMockDescriptor struct {
 // It also returns a function that should be called before the test is done to
 // ensure that the expected number of calls to the mock methods happened. You
 // can pass a *testing.T to it, since it implements the interface it wants.
-func (d KeyValuesRepositoryMockDescriptor) Mock() (m KeyValuesRepositoryMock, assert func(t interface{ Errorf(s string, args ...interface{})  }) (ok bool)) {
+func (d KeyValuesRepositoryMockDescriptor) Mock() (m KeyValuesRepositoryMock, assert func(t interface {
+	Errorf(s string, args ...interface{})
+}) (ok bool)) {
 	assert = d.done()
 	return d.m.Mock(), assert
 }
 
-func (d KeyValuesRepositoryMockDescriptor) done() func(t interface{ Errorf(s string, args ...interface{})  }) bool {
+func (d KeyValuesRepositoryMockDescriptor) done() func(t interface {
+	Errorf(s string, args ...interface{})
+}) bool {
 	var atAssert []func() (method string, errs []string)
 	type specErrs struct {
 		fileLine string
-		errs []string
+		errs     []string
 	}
-	
+
 	if len(d.descriptors_Get) > 0 {
 		for _, desc := range d.descriptors_Get {
 			desc := desc
@@ -102,7 +107,7 @@ func (d KeyValuesRepositoryMockDescriptor) done() func(t interface{ Errorf(s str
 			if len(matching) == 0 {
 				matchingErrs := ""
 				for _, errs := range allErrs {
-					matchingErrs += "\n\tcandidate described at "+errs.fileLine+":\n"
+					matchingErrs += "\n\tcandidate described at " + errs.fileLine + ":\n"
 					for _, err := range errs.errs {
 						matchingErrs += "\n\t\t" + err
 					}
@@ -161,7 +166,7 @@ func (d KeyValuesRepositoryMockDescriptor) done() func(t interface{ Errorf(s str
 			if len(matching) == 0 {
 				matchingErrs := ""
 				for _, errs := range allErrs {
-					matchingErrs += "\n\tcandidate described at "+errs.fileLine+":\n"
+					matchingErrs += "\n\tcandidate described at " + errs.fileLine + ":\n"
 					for _, err := range errs.errs {
 						matchingErrs += "\n\t\t" + err
 					}
@@ -179,7 +184,9 @@ func (d KeyValuesRepositoryMockDescriptor) done() func(t interface{ Errorf(s str
 			panic("unexpected call to mock for KeyValuesRepository.Put")
 		}
 	}
-	return func(t interface{ Errorf(s string, args ...interface{})  }) bool {
+	return func(t interface {
+		Errorf(s string, args ...interface{})
+	}) bool {
 		ok := true
 		for _, assert := range atAssert {
 			method, errs := assert()
@@ -191,7 +198,49 @@ func (d KeyValuesRepositoryMockDescriptor) done() func(t interface{ Errorf(s str
 		return ok
 	}
 }
-	
+
+// KeyValuesRepositoryMockDescribedCall is the last step in the description of a way that a
+// method of KeyValuesRepository is to behave when called, with all expected parameters
+// and the resulting values specified.
+//
+// It has methods to describe the times the method is expected to be called.
+// Otherwise, through the embedded KeyValuesRepositoryMockDescriptor, you can start
+// another method call description, or you can call Mock to end the description
+// and get the resulting mock.
+type KeyValuesRepositoryMockDescribedCall struct {
+	KeyValuesRepositoryMockDescriptor
+	times *func(int) error
+}
+
+// Times lets you specify a exact number of times this method is expected to be
+// called.
+func (d KeyValuesRepositoryMockDescribedCall) Times(times int) KeyValuesRepositoryMockDescriptor {
+	return d.TimesMatching(func(got int) error {
+		if got != times {
+			return fmt.Errorf("expected exactly %d calls, got %d", times, got)
+		}
+		return nil
+	})
+}
+
+// AtLeastTimes lets you specify a minimum number of times this method is expected to be
+// called.
+func (d KeyValuesRepositoryMockDescribedCall) AtLeastTimes(times int) KeyValuesRepositoryMockDescriptor {
+	return d.TimesMatching(func(got int) error {
+		if got < times {
+			return fmt.Errorf("expected at least %d calls, got %d", times, got)
+		}
+		return nil
+	})
+}
+
+// TimesMatching lets you pass a function to accept or reject the number of times
+// this method has been called.
+func (d KeyValuesRepositoryMockDescribedCall) TimesMatching(f func(times int) error) KeyValuesRepositoryMockDescriptor {
+	*d.times = f
+	return d.KeyValuesRepositoryMockDescriptor
+}
+
 // Get starts describing a way method KeyValuesRepository.Get is expected to be called
 // and what it should return.
 //
@@ -203,26 +252,26 @@ func (d KeyValuesRepositoryMockDescriptor) Get() *KeyValuesRepositoryGetMockDesc
 func (d KeyValuesRepositoryMockDescriptor) newKeyValuesRepositoryGetMockDescriptor() *KeyValuesRepositoryGetMockDescriptor {
 	_, file, line, _ := runtime.Caller(2)
 	return &KeyValuesRepositoryGetMockDescriptor{
-		mockDesc: d,
-		times: func(int) error { return nil },
+		mockDesc:     d,
+		times:        func(int) error { return nil },
 		argValidator: func(got_key string) []string { return nil },
-		fileLine: fmt.Sprintf("%s:%d", file, line),
+		fileLine:     fmt.Sprintf("%s:%d", file, line),
 	}
 }
 
 // KeyValuesRepositoryGetMockDescriptor is returned by KeyValuesRepositoryMockDescriptor.Get and
 // holds methods to describe the mock for method KeyValuesRepository.Get.
 type KeyValuesRepositoryGetMockDescriptor struct {
-	mockDesc KeyValuesRepositoryMockDescriptor
-	times func(int) error
+	mockDesc     KeyValuesRepositoryMockDescriptor
+	times        func(int) error
 	argValidator func(got_key string) []string
-	call func(key string) (r0 int, r1 error)
-	fileLine string
+	call         func(key string) (r0 int, r1 error)
+	fileLine     string
 }
-	
+
 // Takes lets you specify a value with which the actual value passed to
 // the mocked method KeyValuesRepository.Get as parameter #1
-// will be compared. 
+// will be compared.
 //
 // Package "github.com/google/go-cmp/cmp" is used to do the comparison. You can
 // pass extra options for it.
@@ -235,7 +284,7 @@ func (d *KeyValuesRepositoryGetMockDescriptor) Takes(key string, opts ...cmp.Opt
 	d.argValidator = func(got_key string) []string {
 		errMsgs := prev(got_key)
 		if diff := cmp.Diff(key, got_key, opts...); diff != "" {
-			errMsgs = append(errMsgs, "parameter #1 mismatch:\n" + diff)
+			errMsgs = append(errMsgs, "parameter #1 mismatch:\n"+diff)
 		}
 		return errMsgs
 	}
@@ -255,7 +304,7 @@ func (d *KeyValuesRepositoryGetMockDescriptor) TakesMatching(match func(key stri
 	d.argValidator = func(got_key string) []string {
 		errMsgs := prev(got_key)
 		if err := match(got_key); err != nil {
-			errMsgs = append(errMsgs, "parameter \"key\" custom matcher error: " + err.Error())
+			errMsgs = append(errMsgs, "parameter \"key\" custom matcher error: "+err.Error())
 		}
 		return errMsgs
 	}
@@ -266,102 +315,35 @@ func (d *KeyValuesRepositoryGetMockDescriptor) TakesMatching(match func(key stri
 // method KeyValuesRepository.Get is expected to be called, with 1
 // arguments specified.
 //
-// It has methods to describe the next argument, if there's
-// any left, or the return values, if there are any, or the times it's expected
-// to be called otherwise.
+// It has methods to describe the next argument, if there's any left, or the
+// return values otherwise.
 type KeyValuesRepositoryGetMockDescriptorWith1Arg struct {
 	methodDesc *KeyValuesRepositoryGetMockDescriptor
 }
-	
+
 // Returns lets you specify the values that the mocked method KeyValuesRepository.Get,
 // if called with values matching the expectations, will return.
-func (d KeyValuesRepositoryGetMockDescriptorWith1Arg) Returns(r0 int, r1 error) KeyValuesRepositoryGetMockDescriptorWithReturn {
+func (d KeyValuesRepositoryGetMockDescriptorWith1Arg) Returns(r0 int, r1 error) KeyValuesRepositoryMockDescribedCall {
 	return d.ReturnsFrom(func(string) (int, error) {
 		return r0, r1
 	})
 }
 
-// Returns lets you specify the values that the mocked method KeyValuesRepository.Get,
+// ReturnsFrom lets you specify the values that the mocked method KeyValuesRepository.Get,
 // if called with values matching the expectations, will return.
-// 
-// It passes such passed values to a function that then returns the return values. 
-func (d KeyValuesRepositoryGetMockDescriptorWith1Arg) ReturnsFrom(f func(key string) (r0 int, r1 error)) KeyValuesRepositoryGetMockDescriptorWithReturn {
+//
+// It passes such passed values to a function that then returns the return values.
+func (d KeyValuesRepositoryGetMockDescriptorWith1Arg) ReturnsFrom(f func(key string) (r0 int, r1 error)) KeyValuesRepositoryMockDescribedCall {
 	d.methodDesc.call = f
-	return KeyValuesRepositoryGetMockDescriptorWithReturn{d.methodDesc}
+	return d.methodDesc.done()
 }
 
-// KeyValuesRepositoryGetMockDescriptorWithReturn is a step forward in the description of a way that
-// method KeyValuesRepository.Get is to behave when called, with all expected parameters
-// and the resulting values specified.
-// arguments specified.
-// 
-// It has methods to describe the times the method is expected to be called,
-// or you can start another method call description, or you can call Mock to
-// end the description and get the resulting mock.
-type KeyValuesRepositoryGetMockDescriptorWithReturn struct {
-	methodDesc *KeyValuesRepositoryGetMockDescriptor
-}
-	
-// Times lets you specify a exact number of times this method is expected to be
-// called.
-func (d KeyValuesRepositoryGetMockDescriptorWithReturn) Times(times int) KeyValuesRepositoryMockDescriptor {
-	return d.TimesMatching(func(got int) error {
-		if got != times {
-			return fmt.Errorf("expected exactly %d calls, got %d", times, got)
-		}
-		return nil
-	})
-}
-
-// AtLeastTimes lets you specify a minimum number of times this method is expected to be
-// called.
-func (d KeyValuesRepositoryGetMockDescriptorWithReturn) AtLeastTimes(times int) KeyValuesRepositoryMockDescriptor {
-	return d.TimesMatching(func(got int) error {
-		if got < times {
-			return fmt.Errorf("expected at least %d calls, got %d", times, got)
-		}
-		return nil
-	})
-}
-
-// TimesMatching lets you pass a function to accept or reject the number of times
-// this method has been called.
-func (d KeyValuesRepositoryGetMockDescriptorWithReturn) TimesMatching(f func(times int) error) KeyValuesRepositoryMockDescriptor {
-	d.methodDesc.times = f
-	d.methodDesc.done()
-	return d.methodDesc.mockDesc
-}
-
-// Mock finishes the description and produces a mock.
-//
-// See KeyValuesRepositoryMockDescriptor.Mock for details.
-func (d KeyValuesRepositoryGetMockDescriptorWithReturn) Mock() (m KeyValuesRepositoryMock, assert func(t interface{ Errorf(string, ...interface{})  }) (ok bool)) {
-	d.methodDesc.done()
-	return d.methodDesc.mockDesc.Mock()
-}
-	
-// Get finishes the current description for method KeyValuesRepository.Get and
-// starts describing for method Get.
-//
-// See KeyValuesRepositoryMockDescriptor.Get for details.
-func (d KeyValuesRepositoryGetMockDescriptorWithReturn) Get() *KeyValuesRepositoryGetMockDescriptor {
-	d.methodDesc.done()
-	return d.methodDesc.mockDesc.newKeyValuesRepositoryGetMockDescriptor()
-}
-	
-// Put finishes the current description for method KeyValuesRepository.Get and
-// starts describing for method Put.
-//
-// See KeyValuesRepositoryMockDescriptor.Put for details.
-func (d KeyValuesRepositoryGetMockDescriptorWithReturn) Put() *KeyValuesRepositoryPutMockDescriptor {
-	d.methodDesc.done()
-	return d.methodDesc.mockDesc.newKeyValuesRepositoryPutMockDescriptor()
-}
-	
-func (d *KeyValuesRepositoryGetMockDescriptor) done() {
+// done ends the description, adding it to the mock descriptor.
+func (d *KeyValuesRepositoryGetMockDescriptor) done() KeyValuesRepositoryMockDescribedCall {
 	d.mockDesc.descriptors_Get = append(d.mockDesc.descriptors_Get, d)
+	return KeyValuesRepositoryMockDescribedCall{d.mockDesc, &d.times}
 }
-	
+
 // Put starts describing a way method KeyValuesRepository.Put is expected to be called
 // and what it should return.
 //
@@ -373,26 +355,26 @@ func (d KeyValuesRepositoryMockDescriptor) Put() *KeyValuesRepositoryPutMockDesc
 func (d KeyValuesRepositoryMockDescriptor) newKeyValuesRepositoryPutMockDescriptor() *KeyValuesRepositoryPutMockDescriptor {
 	_, file, line, _ := runtime.Caller(2)
 	return &KeyValuesRepositoryPutMockDescriptor{
-		mockDesc: d,
-		times: func(int) error { return nil },
+		mockDesc:     d,
+		times:        func(int) error { return nil },
 		argValidator: func(got_key string, got_value int) []string { return nil },
-		fileLine: fmt.Sprintf("%s:%d", file, line),
+		fileLine:     fmt.Sprintf("%s:%d", file, line),
 	}
 }
 
 // KeyValuesRepositoryPutMockDescriptor is returned by KeyValuesRepositoryMockDescriptor.Put and
 // holds methods to describe the mock for method KeyValuesRepository.Put.
 type KeyValuesRepositoryPutMockDescriptor struct {
-	mockDesc KeyValuesRepositoryMockDescriptor
-	times func(int) error
+	mockDesc     KeyValuesRepositoryMockDescriptor
+	times        func(int) error
 	argValidator func(got_key string, got_value int) []string
-	call func(key string, value int) (r0 error)
-	fileLine string
+	call         func(key string, value int) (r0 error)
+	fileLine     string
 }
-	
+
 // Takes lets you specify a value with which the actual value passed to
 // the mocked method KeyValuesRepository.Put as parameter #1
-// will be compared. 
+// will be compared.
 //
 // Package "github.com/google/go-cmp/cmp" is used to do the comparison. You can
 // pass extra options for it.
@@ -405,7 +387,7 @@ func (d *KeyValuesRepositoryPutMockDescriptor) Takes(key string, opts ...cmp.Opt
 	d.argValidator = func(got_key string, got_value int) []string {
 		errMsgs := prev(got_key, got_value)
 		if diff := cmp.Diff(key, got_key, opts...); diff != "" {
-			errMsgs = append(errMsgs, "parameter #1 mismatch:\n" + diff)
+			errMsgs = append(errMsgs, "parameter #1 mismatch:\n"+diff)
 		}
 		return errMsgs
 	}
@@ -425,7 +407,7 @@ func (d *KeyValuesRepositoryPutMockDescriptor) TakesMatching(match func(key stri
 	d.argValidator = func(got_key string, got_value int) []string {
 		errMsgs := prev(got_key, got_value)
 		if err := match(got_key); err != nil {
-			errMsgs = append(errMsgs, "parameter \"key\" custom matcher error: " + err.Error())
+			errMsgs = append(errMsgs, "parameter \"key\" custom matcher error: "+err.Error())
 		}
 		return errMsgs
 	}
@@ -436,16 +418,15 @@ func (d *KeyValuesRepositoryPutMockDescriptor) TakesMatching(match func(key stri
 // method KeyValuesRepository.Put is expected to be called, with 1
 // arguments specified.
 //
-// It has methods to describe the next argument, if there's
-// any left, or the return values, if there are any, or the times it's expected
-// to be called otherwise.
+// It has methods to describe the next argument, if there's any left, or the
+// return values otherwise.
 type KeyValuesRepositoryPutMockDescriptorWith1Arg struct {
 	methodDesc *KeyValuesRepositoryPutMockDescriptor
 }
-	
+
 // And lets you specify a value with which the actual value passed to
 // the mocked method KeyValuesRepository.Put as parameter #2
-// will be compared. 
+// will be compared.
 //
 // Package "github.com/google/go-cmp/cmp" is used to do the comparison. You can
 // pass extra options for it.
@@ -458,7 +439,7 @@ func (d KeyValuesRepositoryPutMockDescriptorWith1Arg) And(value int, opts ...cmp
 	d.methodDesc.argValidator = func(got_key string, got_value int) []string {
 		errMsgs := prev(got_key, got_value)
 		if diff := cmp.Diff(value, got_value, opts...); diff != "" {
-			errMsgs = append(errMsgs, "parameter #2 mismatch:\n" + diff)
+			errMsgs = append(errMsgs, "parameter #2 mismatch:\n"+diff)
 		}
 		return errMsgs
 	}
@@ -478,7 +459,7 @@ func (d KeyValuesRepositoryPutMockDescriptorWith1Arg) AndMatching(match func(val
 	d.methodDesc.argValidator = func(got_key string, got_value int) []string {
 		errMsgs := prev(got_key, got_value)
 		if err := match(got_value); err != nil {
-			errMsgs = append(errMsgs, "parameter \"value\" custom matcher error: " + err.Error())
+			errMsgs = append(errMsgs, "parameter \"value\" custom matcher error: "+err.Error())
 		}
 		return errMsgs
 	}
@@ -489,102 +470,35 @@ func (d KeyValuesRepositoryPutMockDescriptorWith1Arg) AndMatching(match func(val
 // method KeyValuesRepository.Put is expected to be called, with 2
 // arguments specified.
 //
-// It has methods to describe the next argument, if there's
-// any left, or the return values, if there are any, or the times it's expected
-// to be called otherwise.
+// It has methods to describe the next argument, if there's any left, or the
+// return values otherwise.
 type KeyValuesRepositoryPutMockDescriptorWith2Args struct {
 	methodDesc *KeyValuesRepositoryPutMockDescriptor
 }
-	
+
 // Returns lets you specify the values that the mocked method KeyValuesRepository.Put,
 // if called with values matching the expectations, will return.
-func (d KeyValuesRepositoryPutMockDescriptorWith2Args) Returns(r0 error) KeyValuesRepositoryPutMockDescriptorWithReturn {
+func (d KeyValuesRepositoryPutMockDescriptorWith2Args) Returns(r0 error) KeyValuesRepositoryMockDescribedCall {
 	return d.ReturnsFrom(func(string, int) error {
 		return r0
 	})
 }
 
-// Returns lets you specify the values that the mocked method KeyValuesRepository.Put,
+// ReturnsFrom lets you specify the values that the mocked method KeyValuesRepository.Put,
 // if called with values matching the expectations, will return.
-// 
-// It passes such passed values to a function that then returns the return values. 
-func (d KeyValuesRepositoryPutMockDescriptorWith2Args) ReturnsFrom(f func(key string, value int) (r0 error)) KeyValuesRepositoryPutMockDescriptorWithReturn {
+//
+// It passes such passed values to a function that then returns the return values.
+func (d KeyValuesRepositoryPutMockDescriptorWith2Args) ReturnsFrom(f func(key string, value int) (r0 error)) KeyValuesRepositoryMockDescribedCall {
 	d.methodDesc.call = f
-	return KeyValuesRepositoryPutMockDescriptorWithReturn{d.methodDesc}
+	return d.methodDesc.done()
 }
 
-// KeyValuesRepositoryPutMockDescriptorWithReturn is a step forward in the description of a way that
-// method KeyValuesRepository.Put is to behave when called, with all expected parameters
-// and the resulting values specified.
-// arguments specified.
-// 
-// It has methods to describe the times the method is expected to be called,
-// or you can start another method call description, or you can call Mock to
-// end the description and get the resulting mock.
-type KeyValuesRepositoryPutMockDescriptorWithReturn struct {
-	methodDesc *KeyValuesRepositoryPutMockDescriptor
-}
-	
-// Times lets you specify a exact number of times this method is expected to be
-// called.
-func (d KeyValuesRepositoryPutMockDescriptorWithReturn) Times(times int) KeyValuesRepositoryMockDescriptor {
-	return d.TimesMatching(func(got int) error {
-		if got != times {
-			return fmt.Errorf("expected exactly %d calls, got %d", times, got)
-		}
-		return nil
-	})
-}
-
-// AtLeastTimes lets you specify a minimum number of times this method is expected to be
-// called.
-func (d KeyValuesRepositoryPutMockDescriptorWithReturn) AtLeastTimes(times int) KeyValuesRepositoryMockDescriptor {
-	return d.TimesMatching(func(got int) error {
-		if got < times {
-			return fmt.Errorf("expected at least %d calls, got %d", times, got)
-		}
-		return nil
-	})
-}
-
-// TimesMatching lets you pass a function to accept or reject the number of times
-// this method has been called.
-func (d KeyValuesRepositoryPutMockDescriptorWithReturn) TimesMatching(f func(times int) error) KeyValuesRepositoryMockDescriptor {
-	d.methodDesc.times = f
-	d.methodDesc.done()
-	return d.methodDesc.mockDesc
-}
-
-// Mock finishes the description and produces a mock.
-//
-// See KeyValuesRepositoryMockDescriptor.Mock for details.
-func (d KeyValuesRepositoryPutMockDescriptorWithReturn) Mock() (m KeyValuesRepositoryMock, assert func(t interface{ Errorf(string, ...interface{})  }) (ok bool)) {
-	d.methodDesc.done()
-	return d.methodDesc.mockDesc.Mock()
-}
-	
-// Get finishes the current description for method KeyValuesRepository.Put and
-// starts describing for method Get.
-//
-// See KeyValuesRepositoryMockDescriptor.Get for details.
-func (d KeyValuesRepositoryPutMockDescriptorWithReturn) Get() *KeyValuesRepositoryGetMockDescriptor {
-	d.methodDesc.done()
-	return d.methodDesc.mockDesc.newKeyValuesRepositoryGetMockDescriptor()
-}
-	
-// Put finishes the current description for method KeyValuesRepository.Put and
-// starts describing for method Put.
-//
-// See KeyValuesRepositoryMockDescriptor.Put for details.
-func (d KeyValuesRepositoryPutMockDescriptorWithReturn) Put() *KeyValuesRepositoryPutMockDescriptor {
-	d.methodDesc.done()
-	return d.methodDesc.mockDesc.newKeyValuesRepositoryPutMockDescriptor()
-}
-	
-func (d *KeyValuesRepositoryPutMockDescriptor) done() {
+// done ends the description, adding it to the mock descriptor.
+func (d *KeyValuesRepositoryPutMockDescriptor) done() KeyValuesRepositoryMockDescribedCall {
 	d.mockDesc.descriptors_Put = append(d.mockDesc.descriptors_Put, d)
+	return KeyValuesRepositoryMockDescribedCall{d.mockDesc, &d.times}
 }
-	
+
 // Mock returns a mock for KeyValuesRepository that calls the functions
 // defined as struct fields in the receiver.
 //
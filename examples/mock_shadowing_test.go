@@ -0,0 +1,3088 @@
+// Code generated by github.com/tcard/make.go.mock. DO NOT EDIT.
+
+package examples
+
+import (
+	fmt1 "fmt"
+	"os"
+	"runtime"
+	"sync"
+
+	cmp1 "github.com/google/go-cmp/cmp"
+)
+
+// ShadowingMocker builds mocks for type Shadowing.
+//
+// Its fields match the original type's methods. Set those you expect to be
+// called, then call the Mock method to get a mock that implements the original
+// type.
+//
+// If the original type was a function, it is mapped to field Func.
+//
+// The Describe method is a shortcut to define this struct's fields in a
+// declarative manner.
+type ShadowingMocker struct {
+	// Blanks get synthetic names.
+	Blanks func(a0 int, a1 string) (r0 error)
+	// Kept are kept, since they don't clash with anything.
+	Kept func(i int, arg string, err error)
+	// LocalTypes are renamed, as they would shadow types that the mock refers
+	// to unqualified, since it's in the same package.
+	LocalTypes func(User_ User, Store_ *Store)
+	// Locals are renamed, as they would shadow the generated code's local
+	// variables.
+	Locals func(prev_ int, desc_ string, matching_ bool, calls_ int) (d_ int, m_ int)
+	// Predeclared are renamed, as they would shadow predeclared identifiers.
+	Predeclared func(string_ string, len_ int, error_ bool) (true_ bool)
+	// Qualifiers are renamed, as they would shadow imported packages.
+	Qualifiers func(os_ *os.File, fmt_ string, cmp_ int)
+	// Synthetic names are renamed if they are already taken.
+	Synthetic func(a1 int, a1_ string, r0 bool) (r0_ int, r1 error)
+	// Validator's got_x is renamed, as it would clash with the name given to
+	// x in argument validators.
+	Validator func(a0 int, x int)
+}
+
+// Describe lets you describe how the methods on the resulting mock are expected
+// to be called and what they will return.
+//
+// When you're done describing methods, call Mock to get a mock that implements
+// the behavior you described.
+func (m *ShadowingMocker) Describe() ShadowingMockDescriptor {
+	return ShadowingMockDescriptor{m: m}
+}
+
+// A ShadowingMockDescriptor lets you describe how the methods on the resulting mock are expected
+// to be called and what they will return.
+//
+// When you're done describing methods, call its Mock method to get a mock that
+// implements the behavior you described.
+type ShadowingMockDescriptor struct {
+	m                       *ShadowingMocker
+	descriptors_Blanks      []*ShadowingBlanksMockDescriptor
+	descriptors_Kept        []*ShadowingKeptMockDescriptor
+	descriptors_LocalTypes  []*ShadowingLocalTypesMockDescriptor
+	descriptors_Locals      []*ShadowingLocalsMockDescriptor
+	descriptors_Predeclared []*ShadowingPredeclaredMockDescriptor
+	descriptors_Qualifiers  []*ShadowingQualifiersMockDescriptor
+	descriptors_Synthetic   []*ShadowingSyntheticMockDescriptor
+	descriptors_Validator   []*ShadowingValidatorMockDescriptor
+}
+
+// Mock returns a mock that the Shadowing interface, following the behavior
+// described by the descriptor methods.
+//
+// It also returns a function that should be called before the test is done to
+// ensure that the expected number of calls to the mock methods happened. You
+// can pass a *testing.T to it, since it implements the interface it wants.
+func (d ShadowingMockDescriptor) Mock() (m ShadowingMock, assert func(t interface {
+	Errorf(s string, args ...interface{})
+}) (ok bool)) {
+	assert = d.done()
+	return d.m.Mock(), assert
+}
+
+func (d ShadowingMockDescriptor) done() func(t interface {
+	Errorf(s string, args ...interface{})
+}) bool {
+	var atAssert []func() (method string, errs []string)
+	type specErrs struct {
+		fileLine string
+		errs     []string
+	}
+
+	if len(d.descriptors_Blanks) > 0 {
+		for _, desc := range d.descriptors_Blanks {
+			desc := desc
+			calls := 0
+			prev := desc.call
+			desc.call = func(a0 int, a1 string) (r0 error) {
+				calls++
+				return prev(a0, a1)
+			}
+			atAssert = append(atAssert, func() (method string, errs []string) {
+				err := desc.times(calls)
+				if err != nil {
+					return "Blanks", []string{err.Error()}
+				}
+				return "", nil
+			})
+		}
+		d.m.Blanks = func(a0 int, a1 string) (r0 error) {
+			var matching []*ShadowingBlanksMockDescriptor
+			var allErrs []specErrs
+			for _, desc := range d.descriptors_Blanks {
+				errs := desc.argValidator(a0, a1)
+				if len(errs) > 0 {
+					allErrs = append(allErrs, specErrs{desc.fileLine, errs})
+				} else {
+					matching = append(matching, desc)
+				}
+			}
+			if len(matching) == 1 {
+				return matching[0].call(a0, a1)
+			}
+			var args string
+			for i, arg := range []interface{}{a0, a1} {
+				if i != 0 {
+					args += "\n\t"
+				}
+				args += fmt1.Sprintf("%#v", arg)
+			}
+			if len(matching) == 0 {
+				matchingErrs := ""
+				for _, errs := range allErrs {
+					matchingErrs += "\n\tcandidate described at " + errs.fileLine + ":\n"
+					for _, err := range errs.errs {
+						matchingErrs += "\n\t\t" + err
+					}
+				}
+				panic(fmt1.Errorf("no matching candidate for call to mock for Shadowing.Blanks with args:\n\n\t%+v\n\nfailing candidates:\n%s", args, matchingErrs))
+			}
+			matchingLines := ""
+			for _, m := range matching {
+				matchingLines += "\n\tcandidate described at " + m.fileLine
+			}
+			panic(fmt1.Errorf("more than one candidate for call to mock for Shadowing.Blanks with args:\n\n\t%+v\n\nmatching candidates:\n%s", args, matchingLines))
+		}
+	} else {
+		d.m.Blanks = func(a0 int, a1 string) (r0 error) {
+			panic("unexpected call to mock for Shadowing.Blanks")
+		}
+	}
+	if len(d.descriptors_Kept) > 0 {
+		for _, desc := range d.descriptors_Kept {
+			desc := desc
+			calls := 0
+			desc.call = func(i int, arg string, err error) {
+				calls++
+			}
+			atAssert = append(atAssert, func() (method string, errs []string) {
+				err := desc.times(calls)
+				if err != nil {
+					return "Kept", []string{err.Error()}
+				}
+				return "", nil
+			})
+		}
+		d.m.Kept = func(i int, arg string, err error) {
+			var matching []*ShadowingKeptMockDescriptor
+			var allErrs []specErrs
+			for _, desc := range d.descriptors_Kept {
+				errs := desc.argValidator(i, arg, err)
+				if len(errs) > 0 {
+					allErrs = append(allErrs, specErrs{desc.fileLine, errs})
+				} else {
+					matching = append(matching, desc)
+				}
+			}
+			if len(matching) == 1 {
+				matching[0].call(i, arg, err)
+				return
+			}
+			var args string
+			for i, arg := range []interface{}{i, arg, err} {
+				if i != 0 {
+					args += "\n\t"
+				}
+				args += fmt1.Sprintf("%#v", arg)
+			}
+			if len(matching) == 0 {
+				matchingErrs := ""
+				for _, errs := range allErrs {
+					matchingErrs += "\n\tcandidate described at " + errs.fileLine + ":\n"
+					for _, err := range errs.errs {
+						matchingErrs += "\n\t\t" + err
+					}
+				}
+				panic(fmt1.Errorf("no matching candidate for call to mock for Shadowing.Kept with args:\n\n\t%+v\n\nfailing candidates:\n%s", args, matchingErrs))
+			}
+			matchingLines := ""
+			for _, m := range matching {
+				matchingLines += "\n\tcandidate described at " + m.fileLine
+			}
+			panic(fmt1.Errorf("more than one candidate for call to mock for Shadowing.Kept with args:\n\n\t%+v\n\nmatching candidates:\n%s", args, matchingLines))
+		}
+	} else {
+		d.m.Kept = func(i int, arg string, err error) {
+			panic("unexpected call to mock for Shadowing.Kept")
+		}
+	}
+	if len(d.descriptors_LocalTypes) > 0 {
+		for _, desc := range d.descriptors_LocalTypes {
+			desc := desc
+			calls := 0
+			desc.call = func(User_ User, Store_ *Store) {
+				calls++
+			}
+			atAssert = append(atAssert, func() (method string, errs []string) {
+				err := desc.times(calls)
+				if err != nil {
+					return "LocalTypes", []string{err.Error()}
+				}
+				return "", nil
+			})
+		}
+		d.m.LocalTypes = func(User_ User, Store_ *Store) {
+			var matching []*ShadowingLocalTypesMockDescriptor
+			var allErrs []specErrs
+			for _, desc := range d.descriptors_LocalTypes {
+				errs := desc.argValidator(User_, Store_)
+				if len(errs) > 0 {
+					allErrs = append(allErrs, specErrs{desc.fileLine, errs})
+				} else {
+					matching = append(matching, desc)
+				}
+			}
+			if len(matching) == 1 {
+				matching[0].call(User_, Store_)
+				return
+			}
+			var args string
+			for i, arg := range []interface{}{User_, Store_} {
+				if i != 0 {
+					args += "\n\t"
+				}
+				args += fmt1.Sprintf("%#v", arg)
+			}
+			if len(matching) == 0 {
+				matchingErrs := ""
+				for _, errs := range allErrs {
+					matchingErrs += "\n\tcandidate described at " + errs.fileLine + ":\n"
+					for _, err := range errs.errs {
+						matchingErrs += "\n\t\t" + err
+					}
+				}
+				panic(fmt1.Errorf("no matching candidate for call to mock for Shadowing.LocalTypes with args:\n\n\t%+v\n\nfailing candidates:\n%s", args, matchingErrs))
+			}
+			matchingLines := ""
+			for _, m := range matching {
+				matchingLines += "\n\tcandidate described at " + m.fileLine
+			}
+			panic(fmt1.Errorf("more than one candidate for call to mock for Shadowing.LocalTypes with args:\n\n\t%+v\n\nmatching candidates:\n%s", args, matchingLines))
+		}
+	} else {
+		d.m.LocalTypes = func(User_ User, Store_ *Store) {
+			panic("unexpected call to mock for Shadowing.LocalTypes")
+		}
+	}
+	if len(d.descriptors_Locals) > 0 {
+		for _, desc := range d.descriptors_Locals {
+			desc := desc
+			calls := 0
+			prev := desc.call
+			desc.call = func(prev_ int, desc_ string, matching_ bool, calls_ int) (d_ int, m_ int) {
+				calls++
+				return prev(prev_, desc_, matching_, calls_)
+			}
+			atAssert = append(atAssert, func() (method string, errs []string) {
+				err := desc.times(calls)
+				if err != nil {
+					return "Locals", []string{err.Error()}
+				}
+				return "", nil
+			})
+		}
+		d.m.Locals = func(prev_ int, desc_ string, matching_ bool, calls_ int) (d_ int, m_ int) {
+			var matching []*ShadowingLocalsMockDescriptor
+			var allErrs []specErrs
+			for _, desc := range d.descriptors_Locals {
+				errs := desc.argValidator(prev_, desc_, matching_, calls_)
+				if len(errs) > 0 {
+					allErrs = append(allErrs, specErrs{desc.fileLine, errs})
+				} else {
+					matching = append(matching, desc)
+				}
+			}
+			if len(matching) == 1 {
+				return matching[0].call(prev_, desc_, matching_, calls_)
+			}
+			var args string
+			for i, arg := range []interface{}{prev_, desc_, matching_, calls_} {
+				if i != 0 {
+					args += "\n\t"
+				}
+				args += fmt1.Sprintf("%#v", arg)
+			}
+			if len(matching) == 0 {
+				matchingErrs := ""
+				for _, errs := range allErrs {
+					matchingErrs += "\n\tcandidate described at " + errs.fileLine + ":\n"
+					for _, err := range errs.errs {
+						matchingErrs += "\n\t\t" + err
+					}
+				}
+				panic(fmt1.Errorf("no matching candidate for call to mock for Shadowing.Locals with args:\n\n\t%+v\n\nfailing candidates:\n%s", args, matchingErrs))
+			}
+			matchingLines := ""
+			for _, m := range matching {
+				matchingLines += "\n\tcandidate described at " + m.fileLine
+			}
+			panic(fmt1.Errorf("more than one candidate for call to mock for Shadowing.Locals with args:\n\n\t%+v\n\nmatching candidates:\n%s", args, matchingLines))
+		}
+	} else {
+		d.m.Locals = func(prev_ int, desc_ string, matching_ bool, calls_ int) (d_ int, m_ int) {
+			panic("unexpected call to mock for Shadowing.Locals")
+		}
+	}
+	if len(d.descriptors_Predeclared) > 0 {
+		for _, desc := range d.descriptors_Predeclared {
+			desc := desc
+			calls := 0
+			prev := desc.call
+			desc.call = func(string_ string, len_ int, error_ bool) (true_ bool) {
+				calls++
+				return prev(string_, len_, error_)
+			}
+			atAssert = append(atAssert, func() (method string, errs []string) {
+				err := desc.times(calls)
+				if err != nil {
+					return "Predeclared", []string{err.Error()}
+				}
+				return "", nil
+			})
+		}
+		d.m.Predeclared = func(string_ string, len_ int, error_ bool) (true_ bool) {
+			var matching []*ShadowingPredeclaredMockDescriptor
+			var allErrs []specErrs
+			for _, desc := range d.descriptors_Predeclared {
+				errs := desc.argValidator(string_, len_, error_)
+				if len(errs) > 0 {
+					allErrs = append(allErrs, specErrs{desc.fileLine, errs})
+				} else {
+					matching = append(matching, desc)
+				}
+			}
+			if len(matching) == 1 {
+				return matching[0].call(string_, len_, error_)
+			}
+			var args string
+			for i, arg := range []interface{}{string_, len_, error_} {
+				if i != 0 {
+					args += "\n\t"
+				}
+				args += fmt1.Sprintf("%#v", arg)
+			}
+			if len(matching) == 0 {
+				matchingErrs := ""
+				for _, errs := range allErrs {
+					matchingErrs += "\n\tcandidate described at " + errs.fileLine + ":\n"
+					for _, err := range errs.errs {
+						matchingErrs += "\n\t\t" + err
+					}
+				}
+				panic(fmt1.Errorf("no matching candidate for call to mock for Shadowing.Predeclared with args:\n\n\t%+v\n\nfailing candidates:\n%s", args, matchingErrs))
+			}
+			matchingLines := ""
+			for _, m := range matching {
+				matchingLines += "\n\tcandidate described at " + m.fileLine
+			}
+			panic(fmt1.Errorf("more than one candidate for call to mock for Shadowing.Predeclared with args:\n\n\t%+v\n\nmatching candidates:\n%s", args, matchingLines))
+		}
+	} else {
+		d.m.Predeclared = func(string_ string, len_ int, error_ bool) (true_ bool) {
+			panic("unexpected call to mock for Shadowing.Predeclared")
+		}
+	}
+	if len(d.descriptors_Qualifiers) > 0 {
+		for _, desc := range d.descriptors_Qualifiers {
+			desc := desc
+			calls := 0
+			desc.call = func(os_ *os.File, fmt_ string, cmp_ int) {
+				calls++
+			}
+			atAssert = append(atAssert, func() (method string, errs []string) {
+				err := desc.times(calls)
+				if err != nil {
+					return "Qualifiers", []string{err.Error()}
+				}
+				return "", nil
+			})
+		}
+		d.m.Qualifiers = func(os_ *os.File, fmt_ string, cmp_ int) {
+			var matching []*ShadowingQualifiersMockDescriptor
+			var allErrs []specErrs
+			for _, desc := range d.descriptors_Qualifiers {
+				errs := desc.argValidator(os_, fmt_, cmp_)
+				if len(errs) > 0 {
+					allErrs = append(allErrs, specErrs{desc.fileLine, errs})
+				} else {
+					matching = append(matching, desc)
+				}
+			}
+			if len(matching) == 1 {
+				matching[0].call(os_, fmt_, cmp_)
+				return
+			}
+			var args string
+			for i, arg := range []interface{}{os_, fmt_, cmp_} {
+				if i != 0 {
+					args += "\n\t"
+				}
+				args += fmt1.Sprintf("%#v", arg)
+			}
+			if len(matching) == 0 {
+				matchingErrs := ""
+				for _, errs := range allErrs {
+					matchingErrs += "\n\tcandidate described at " + errs.fileLine + ":\n"
+					for _, err := range errs.errs {
+						matchingErrs += "\n\t\t" + err
+					}
+				}
+				panic(fmt1.Errorf("no matching candidate for call to mock for Shadowing.Qualifiers with args:\n\n\t%+v\n\nfailing candidates:\n%s", args, matchingErrs))
+			}
+			matchingLines := ""
+			for _, m := range matching {
+				matchingLines += "\n\tcandidate described at " + m.fileLine
+			}
+			panic(fmt1.Errorf("more than one candidate for call to mock for Shadowing.Qualifiers with args:\n\n\t%+v\n\nmatching candidates:\n%s", args, matchingLines))
+		}
+	} else {
+		d.m.Qualifiers = func(os_ *os.File, fmt_ string, cmp_ int) {
+			panic("unexpected call to mock for Shadowing.Qualifiers")
+		}
+	}
+	if len(d.descriptors_Synthetic) > 0 {
+		for _, desc := range d.descriptors_Synthetic {
+			desc := desc
+			calls := 0
+			prev := desc.call
+			desc.call = func(a1 int, a1_ string, r0 bool) (r0_ int, r1 error) {
+				calls++
+				return prev(a1, a1_, r0)
+			}
+			atAssert = append(atAssert, func() (method string, errs []string) {
+				err := desc.times(calls)
+				if err != nil {
+					return "Synthetic", []string{err.Error()}
+				}
+				return "", nil
+			})
+		}
+		d.m.Synthetic = func(a1 int, a1_ string, r0 bool) (r0_ int, r1 error) {
+			var matching []*ShadowingSyntheticMockDescriptor
+			var allErrs []specErrs
+			for _, desc := range d.descriptors_Synthetic {
+				errs := desc.argValidator(a1, a1_, r0)
+				if len(errs) > 0 {
+					allErrs = append(allErrs, specErrs{desc.fileLine, errs})
+				} else {
+					matching = append(matching, desc)
+				}
+			}
+			if len(matching) == 1 {
+				return matching[0].call(a1, a1_, r0)
+			}
+			var args string
+			for i, arg := range []interface{}{a1, a1_, r0} {
+				if i != 0 {
+					args += "\n\t"
+				}
+				args += fmt1.Sprintf("%#v", arg)
+			}
+			if len(matching) == 0 {
+				matchingErrs := ""
+				for _, errs := range allErrs {
+					matchingErrs += "\n\tcandidate described at " + errs.fileLine + ":\n"
+					for _, err := range errs.errs {
+						matchingErrs += "\n\t\t" + err
+					}
+				}
+				panic(fmt1.Errorf("no matching candidate for call to mock for Shadowing.Synthetic with args:\n\n\t%+v\n\nfailing candidates:\n%s", args, matchingErrs))
+			}
+			matchingLines := ""
+			for _, m := range matching {
+				matchingLines += "\n\tcandidate described at " + m.fileLine
+			}
+			panic(fmt1.Errorf("more than one candidate for call to mock for Shadowing.Synthetic with args:\n\n\t%+v\n\nmatching candidates:\n%s", args, matchingLines))
+		}
+	} else {
+		d.m.Synthetic = func(a1 int, a1_ string, r0 bool) (r0_ int, r1 error) {
+			panic("unexpected call to mock for Shadowing.Synthetic")
+		}
+	}
+	if len(d.descriptors_Validator) > 0 {
+		for _, desc := range d.descriptors_Validator {
+			desc := desc
+			calls := 0
+			desc.call = func(a0 int, x int) {
+				calls++
+			}
+			atAssert = append(atAssert, func() (method string, errs []string) {
+				err := desc.times(calls)
+				if err != nil {
+					return "Validator", []string{err.Error()}
+				}
+				return "", nil
+			})
+		}
+		d.m.Validator = func(a0 int, x int) {
+			var matching []*ShadowingValidatorMockDescriptor
+			var allErrs []specErrs
+			for _, desc := range d.descriptors_Validator {
+				errs := desc.argValidator(a0, x)
+				if len(errs) > 0 {
+					allErrs = append(allErrs, specErrs{desc.fileLine, errs})
+				} else {
+					matching = append(matching, desc)
+				}
+			}
+			if len(matching) == 1 {
+				matching[0].call(a0, x)
+				return
+			}
+			var args string
+			for i, arg := range []interface{}{a0, x} {
+				if i != 0 {
+					args += "\n\t"
+				}
+				args += fmt1.Sprintf("%#v", arg)
+			}
+			if len(matching) == 0 {
+				matchingErrs := ""
+				for _, errs := range allErrs {
+					matchingErrs += "\n\tcandidate described at " + errs.fileLine + ":\n"
+					for _, err := range errs.errs {
+						matchingErrs += "\n\t\t" + err
+					}
+				}
+				panic(fmt1.Errorf("no matching candidate for call to mock for Shadowing.Validator with args:\n\n\t%+v\n\nfailing candidates:\n%s", args, matchingErrs))
+			}
+			matchingLines := ""
+			for _, m := range matching {
+				matchingLines += "\n\tcandidate described at " + m.fileLine
+			}
+			panic(fmt1.Errorf("more than one candidate for call to mock for Shadowing.Validator with args:\n\n\t%+v\n\nmatching candidates:\n%s", args, matchingLines))
+		}
+	} else {
+		d.m.Validator = func(a0 int, x int) {
+			panic("unexpected call to mock for Shadowing.Validator")
+		}
+	}
+	return func(t interface {
+		Errorf(s string, args ...interface{})
+	}) bool {
+		ok := true
+		for _, assert := range atAssert {
+			method, errs := assert()
+			for _, err := range errs {
+				ok = false
+				t.Errorf("mock for Shadowing.%s: %s", method, err)
+			}
+		}
+		return ok
+	}
+}
+
+// ShadowingMockDescribedCall is the last step in the description of a way that a
+// method of Shadowing is to behave when called, with all expected parameters
+// and the resulting values specified.
+//
+// It has methods to describe the times the method is expected to be called.
+// Otherwise, through the embedded ShadowingMockDescriptor, you can start
+// another method call description, or you can call Mock to end the description
+// and get the resulting mock.
+type ShadowingMockDescribedCall struct {
+	ShadowingMockDescriptor
+	times *func(int) error
+}
+
+// Times lets you specify a exact number of times this method is expected to be
+// called.
+func (d ShadowingMockDescribedCall) Times(times int) ShadowingMockDescriptor {
+	return d.TimesMatching(func(got int) error {
+		if got != times {
+			return fmt1.Errorf("expected exactly %d calls, got %d", times, got)
+		}
+		return nil
+	})
+}
+
+// AtLeastTimes lets you specify a minimum number of times this method is expected to be
+// called.
+func (d ShadowingMockDescribedCall) AtLeastTimes(times int) ShadowingMockDescriptor {
+	return d.TimesMatching(func(got int) error {
+		if got < times {
+			return fmt1.Errorf("expected at least %d calls, got %d", times, got)
+		}
+		return nil
+	})
+}
+
+// TimesMatching lets you pass a function to accept or reject the number of times
+// this method has been called.
+func (d ShadowingMockDescribedCall) TimesMatching(f func(times int) error) ShadowingMockDescriptor {
+	*d.times = f
+	return d.ShadowingMockDescriptor
+}
+
+// Blanks starts describing a way method Shadowing.Blanks is expected to be called
+// and what it should return.
+//
+// You can call it several times to describe different behaviors, each matching different parameters.
+//
+// Shadowing.Blanks is documented as follows.
+//
+// Blanks get synthetic names.
+func (d ShadowingMockDescriptor) Blanks() *ShadowingBlanksMockDescriptor {
+	return d.newShadowingBlanksMockDescriptor()
+}
+
+func (d ShadowingMockDescriptor) newShadowingBlanksMockDescriptor() *ShadowingBlanksMockDescriptor {
+	_, file, line, _ := runtime.Caller(2)
+	return &ShadowingBlanksMockDescriptor{
+		mockDesc:     d,
+		times:        func(int) error { return nil },
+		argValidator: func(got_a0 int, got_a1 string) []string { return nil },
+		fileLine:     fmt1.Sprintf("%s:%d", file, line),
+	}
+}
+
+// ShadowingBlanksMockDescriptor is returned by ShadowingMockDescriptor.Blanks and
+// holds methods to describe the mock for method Shadowing.Blanks.
+type ShadowingBlanksMockDescriptor struct {
+	mockDesc     ShadowingMockDescriptor
+	times        func(int) error
+	argValidator func(got_a0 int, got_a1 string) []string
+	call         func(a0 int, a1 string) (r0 error)
+	fileLine     string
+}
+
+// Takes lets you specify a value with which the actual value passed to
+// the mocked method Shadowing.Blanks as parameter #1
+// will be compared.
+//
+// Package "github.com/google/go-cmp/cmp" is used to do the comparison. You can
+// pass extra options for it.
+//
+// If you want to accept any value, use TakesAny.
+//
+// If you want more complex validation logic, use TakesMatching.
+func (d *ShadowingBlanksMockDescriptor) Takes(a0 int, opts ...cmp1.Option) ShadowingBlanksMockDescriptorWith1Arg {
+	prev := d.argValidator
+	d.argValidator = func(got_a0 int, got_a1 string) []string {
+		errMsgs := prev(got_a0, got_a1)
+		if diff := cmp1.Diff(a0, got_a0, opts...); diff != "" {
+			errMsgs = append(errMsgs, "parameter #1 mismatch:\n"+diff)
+		}
+		return errMsgs
+	}
+	return ShadowingBlanksMockDescriptorWith1Arg{d}
+}
+
+// TakesAny declares that any value passed to the mocked method
+// Blanks as parameter #1 is expected.
+func (d *ShadowingBlanksMockDescriptor) TakesAny() ShadowingBlanksMockDescriptorWith1Arg {
+	return ShadowingBlanksMockDescriptorWith1Arg{d}
+}
+
+// TakesMatching lets you pass a function to accept or reject the actual
+// value passed to the mocked method Shadowing.Blanks as parameter #1.
+func (d *ShadowingBlanksMockDescriptor) TakesMatching(match func(a0 int) error) ShadowingBlanksMockDescriptorWith1Arg {
+	prev := d.argValidator
+	d.argValidator = func(got_a0 int, got_a1 string) []string {
+		errMsgs := prev(got_a0, got_a1)
+		if err := match(got_a0); err != nil {
+			errMsgs = append(errMsgs, "parameter \"a0\" custom matcher error: "+err.Error())
+		}
+		return errMsgs
+	}
+	return ShadowingBlanksMockDescriptorWith1Arg{d}
+}
+
+// ShadowingBlanksMockDescriptorWith1Arg is a step forward in the description of a way that the
+// method Shadowing.Blanks is expected to be called, with 1
+// arguments specified.
+//
+// It has methods to describe the next argument, if there's any left, or the
+// return values otherwise.
+type ShadowingBlanksMockDescriptorWith1Arg struct {
+	methodDesc *ShadowingBlanksMockDescriptor
+}
+
+// And lets you specify a value with which the actual value passed to
+// the mocked method Shadowing.Blanks as parameter #2
+// will be compared.
+//
+// Package "github.com/google/go-cmp/cmp" is used to do the comparison. You can
+// pass extra options for it.
+//
+// If you want to accept any value, use AndAny.
+//
+// If you want more complex validation logic, use AndMatching.
+func (d ShadowingBlanksMockDescriptorWith1Arg) And(a1 string, opts ...cmp1.Option) ShadowingBlanksMockDescriptorWith2Args {
+	prev := d.methodDesc.argValidator
+	d.methodDesc.argValidator = func(got_a0 int, got_a1 string) []string {
+		errMsgs := prev(got_a0, got_a1)
+		if diff := cmp1.Diff(a1, got_a1, opts...); diff != "" {
+			errMsgs = append(errMsgs, "parameter #2 mismatch:\n"+diff)
+		}
+		return errMsgs
+	}
+	return ShadowingBlanksMockDescriptorWith2Args{d.methodDesc}
+}
+
+// AndAny declares that any value passed to the mocked method
+// Blanks as parameter #2 is expected.
+func (d ShadowingBlanksMockDescriptorWith1Arg) AndAny() ShadowingBlanksMockDescriptorWith2Args {
+	return ShadowingBlanksMockDescriptorWith2Args{d.methodDesc}
+}
+
+// AndMatching lets you pass a function to accept or reject the actual
+// value passed to the mocked method Shadowing.Blanks as parameter #2.
+func (d ShadowingBlanksMockDescriptorWith1Arg) AndMatching(match func(a1 string) error) ShadowingBlanksMockDescriptorWith2Args {
+	prev := d.methodDesc.argValidator
+	d.methodDesc.argValidator = func(got_a0 int, got_a1 string) []string {
+		errMsgs := prev(got_a0, got_a1)
+		if err := match(got_a1); err != nil {
+			errMsgs = append(errMsgs, "parameter \"a1\" custom matcher error: "+err.Error())
+		}
+		return errMsgs
+	}
+	return ShadowingBlanksMockDescriptorWith2Args{d.methodDesc}
+}
+
+// ShadowingBlanksMockDescriptorWith2Args is a step forward in the description of a way that the
+// method Shadowing.Blanks is expected to be called, with 2
+// arguments specified.
+//
+// It has methods to describe the next argument, if there's any left, or the
+// return values otherwise.
+type ShadowingBlanksMockDescriptorWith2Args struct {
+	methodDesc *ShadowingBlanksMockDescriptor
+}
+
+// Returns lets you specify the values that the mocked method Shadowing.Blanks,
+// if called with values matching the expectations, will return.
+func (d ShadowingBlanksMockDescriptorWith2Args) Returns(r0 error) ShadowingMockDescribedCall {
+	return d.ReturnsFrom(func(int, string) error {
+		return r0
+	})
+}
+
+// ReturnsFrom lets you specify the values that the mocked method Shadowing.Blanks,
+// if called with values matching the expectations, will return.
+//
+// It passes such passed values to a function that then returns the return values.
+func (d ShadowingBlanksMockDescriptorWith2Args) ReturnsFrom(f func(a0 int, a1 string) (r0 error)) ShadowingMockDescribedCall {
+	d.methodDesc.call = f
+	return d.methodDesc.done()
+}
+
+// done ends the description, adding it to the mock descriptor.
+func (d *ShadowingBlanksMockDescriptor) done() ShadowingMockDescribedCall {
+	d.mockDesc.descriptors_Blanks = append(d.mockDesc.descriptors_Blanks, d)
+	return ShadowingMockDescribedCall{d.mockDesc, &d.times}
+}
+
+// Kept starts describing a way method Shadowing.Kept is expected to be called
+// and what it should return.
+//
+// You can call it several times to describe different behaviors, each matching different parameters.
+//
+// Shadowing.Kept is documented as follows.
+//
+// Kept are kept, since they don't clash with anything.
+func (d ShadowingMockDescriptor) Kept() *ShadowingKeptMockDescriptor {
+	return d.newShadowingKeptMockDescriptor()
+}
+
+func (d ShadowingMockDescriptor) newShadowingKeptMockDescriptor() *ShadowingKeptMockDescriptor {
+	_, file, line, _ := runtime.Caller(2)
+	return &ShadowingKeptMockDescriptor{
+		mockDesc:     d,
+		times:        func(int) error { return nil },
+		argValidator: func(got_i int, got_arg string, got_err error) []string { return nil },
+		fileLine:     fmt1.Sprintf("%s:%d", file, line),
+	}
+}
+
+// ShadowingKeptMockDescriptor is returned by ShadowingMockDescriptor.Kept and
+// holds methods to describe the mock for method Shadowing.Kept.
+type ShadowingKeptMockDescriptor struct {
+	mockDesc     ShadowingMockDescriptor
+	times        func(int) error
+	argValidator func(got_i int, got_arg string, got_err error) []string
+	call         func(i int, arg string, err error)
+	fileLine     string
+}
+
+// Takes lets you specify a value with which the actual value passed to
+// the mocked method Shadowing.Kept as parameter #1
+// will be compared.
+//
+// Package "github.com/google/go-cmp/cmp" is used to do the comparison. You can
+// pass extra options for it.
+//
+// If you want to accept any value, use TakesAny.
+//
+// If you want more complex validation logic, use TakesMatching.
+func (d *ShadowingKeptMockDescriptor) Takes(i int, opts ...cmp1.Option) ShadowingKeptMockDescriptorWith1Arg {
+	prev := d.argValidator
+	d.argValidator = func(got_i int, got_arg string, got_err error) []string {
+		errMsgs := prev(got_i, got_arg, got_err)
+		if diff := cmp1.Diff(i, got_i, opts...); diff != "" {
+			errMsgs = append(errMsgs, "parameter #1 mismatch:\n"+diff)
+		}
+		return errMsgs
+	}
+	return ShadowingKeptMockDescriptorWith1Arg{d}
+}
+
+// TakesAny declares that any value passed to the mocked method
+// Kept as parameter #1 is expected.
+func (d *ShadowingKeptMockDescriptor) TakesAny() ShadowingKeptMockDescriptorWith1Arg {
+	return ShadowingKeptMockDescriptorWith1Arg{d}
+}
+
+// TakesMatching lets you pass a function to accept or reject the actual
+// value passed to the mocked method Shadowing.Kept as parameter #1.
+func (d *ShadowingKeptMockDescriptor) TakesMatching(match func(i int) error) ShadowingKeptMockDescriptorWith1Arg {
+	prev := d.argValidator
+	d.argValidator = func(got_i int, got_arg string, got_err error) []string {
+		errMsgs := prev(got_i, got_arg, got_err)
+		if err := match(got_i); err != nil {
+			errMsgs = append(errMsgs, "parameter \"i\" custom matcher error: "+err.Error())
+		}
+		return errMsgs
+	}
+	return ShadowingKeptMockDescriptorWith1Arg{d}
+}
+
+// ShadowingKeptMockDescriptorWith1Arg is a step forward in the description of a way that the
+// method Shadowing.Kept is expected to be called, with 1
+// arguments specified.
+//
+// It has methods to describe the next argument, if there's any left, or the
+// return values otherwise.
+type ShadowingKeptMockDescriptorWith1Arg struct {
+	methodDesc *ShadowingKeptMockDescriptor
+}
+
+// And lets you specify a value with which the actual value passed to
+// the mocked method Shadowing.Kept as parameter #2
+// will be compared.
+//
+// Package "github.com/google/go-cmp/cmp" is used to do the comparison. You can
+// pass extra options for it.
+//
+// If you want to accept any value, use AndAny.
+//
+// If you want more complex validation logic, use AndMatching.
+func (d ShadowingKeptMockDescriptorWith1Arg) And(arg string, opts ...cmp1.Option) ShadowingKeptMockDescriptorWith2Args {
+	prev := d.methodDesc.argValidator
+	d.methodDesc.argValidator = func(got_i int, got_arg string, got_err error) []string {
+		errMsgs := prev(got_i, got_arg, got_err)
+		if diff := cmp1.Diff(arg, got_arg, opts...); diff != "" {
+			errMsgs = append(errMsgs, "parameter #2 mismatch:\n"+diff)
+		}
+		return errMsgs
+	}
+	return ShadowingKeptMockDescriptorWith2Args{d.methodDesc}
+}
+
+// AndAny declares that any value passed to the mocked method
+// Kept as parameter #2 is expected.
+func (d ShadowingKeptMockDescriptorWith1Arg) AndAny() ShadowingKeptMockDescriptorWith2Args {
+	return ShadowingKeptMockDescriptorWith2Args{d.methodDesc}
+}
+
+// AndMatching lets you pass a function to accept or reject the actual
+// value passed to the mocked method Shadowing.Kept as parameter #2.
+func (d ShadowingKeptMockDescriptorWith1Arg) AndMatching(match func(arg string) error) ShadowingKeptMockDescriptorWith2Args {
+	prev := d.methodDesc.argValidator
+	d.methodDesc.argValidator = func(got_i int, got_arg string, got_err error) []string {
+		errMsgs := prev(got_i, got_arg, got_err)
+		if err := match(got_arg); err != nil {
+			errMsgs = append(errMsgs, "parameter \"arg\" custom matcher error: "+err.Error())
+		}
+		return errMsgs
+	}
+	return ShadowingKeptMockDescriptorWith2Args{d.methodDesc}
+}
+
+// ShadowingKeptMockDescriptorWith2Args is a step forward in the description of a way that the
+// method Shadowing.Kept is expected to be called, with 2
+// arguments specified.
+//
+// It has methods to describe the next argument, if there's any left, or the
+// return values otherwise.
+type ShadowingKeptMockDescriptorWith2Args struct {
+	methodDesc *ShadowingKeptMockDescriptor
+}
+
+// And lets you specify a value with which the actual value passed to
+// the mocked method Shadowing.Kept as parameter #3
+// will be compared.
+//
+// Package "github.com/google/go-cmp/cmp" is used to do the comparison. You can
+// pass extra options for it.
+//
+// If you want to accept any value, use AndAny.
+//
+// If you want more complex validation logic, use AndMatching.
+func (d ShadowingKeptMockDescriptorWith2Args) And(err error, opts ...cmp1.Option) ShadowingMockDescribedCall {
+	prev := d.methodDesc.argValidator
+	d.methodDesc.argValidator = func(got_i int, got_arg string, got_err error) []string {
+		errMsgs := prev(got_i, got_arg, got_err)
+		if diff := cmp1.Diff(err, got_err, opts...); diff != "" {
+			errMsgs = append(errMsgs, "parameter #3 mismatch:\n"+diff)
+		}
+		return errMsgs
+	}
+	return d.methodDesc.done()
+}
+
+// AndAny declares that any value passed to the mocked method
+// Kept as parameter #3 is expected.
+func (d ShadowingKeptMockDescriptorWith2Args) AndAny() ShadowingMockDescribedCall {
+	return d.methodDesc.done()
+}
+
+// AndMatching lets you pass a function to accept or reject the actual
+// value passed to the mocked method Shadowing.Kept as parameter #3.
+func (d ShadowingKeptMockDescriptorWith2Args) AndMatching(match func(err error) error) ShadowingMockDescribedCall {
+	prev := d.methodDesc.argValidator
+	d.methodDesc.argValidator = func(got_i int, got_arg string, got_err error) []string {
+		errMsgs := prev(got_i, got_arg, got_err)
+		if err := match(got_err); err != nil {
+			errMsgs = append(errMsgs, "parameter \"err\" custom matcher error: "+err.Error())
+		}
+		return errMsgs
+	}
+	return d.methodDesc.done()
+}
+
+// done ends the description, adding it to the mock descriptor.
+func (d *ShadowingKeptMockDescriptor) done() ShadowingMockDescribedCall {
+	d.mockDesc.descriptors_Kept = append(d.mockDesc.descriptors_Kept, d)
+	return ShadowingMockDescribedCall{d.mockDesc, &d.times}
+}
+
+// LocalTypes starts describing a way method Shadowing.LocalTypes is expected to be called
+// and what it should return.
+//
+// You can call it several times to describe different behaviors, each matching different parameters.
+//
+// Shadowing.LocalTypes is documented as follows.
+//
+// LocalTypes are renamed, as they would shadow types that the mock refers
+// to unqualified, since it's in the same package.
+func (d ShadowingMockDescriptor) LocalTypes() *ShadowingLocalTypesMockDescriptor {
+	return d.newShadowingLocalTypesMockDescriptor()
+}
+
+func (d ShadowingMockDescriptor) newShadowingLocalTypesMockDescriptor() *ShadowingLocalTypesMockDescriptor {
+	_, file, line, _ := runtime.Caller(2)
+	return &ShadowingLocalTypesMockDescriptor{
+		mockDesc:     d,
+		times:        func(int) error { return nil },
+		argValidator: func(got_User_ User, got_Store_ *Store) []string { return nil },
+		fileLine:     fmt1.Sprintf("%s:%d", file, line),
+	}
+}
+
+// ShadowingLocalTypesMockDescriptor is returned by ShadowingMockDescriptor.LocalTypes and
+// holds methods to describe the mock for method Shadowing.LocalTypes.
+type ShadowingLocalTypesMockDescriptor struct {
+	mockDesc     ShadowingMockDescriptor
+	times        func(int) error
+	argValidator func(got_User_ User, got_Store_ *Store) []string
+	call         func(User_ User, Store_ *Store)
+	fileLine     string
+}
+
+// Takes lets you specify a value with which the actual value passed to
+// the mocked method Shadowing.LocalTypes as parameter #1
+// will be compared.
+//
+// Package "github.com/google/go-cmp/cmp" is used to do the comparison. You can
+// pass extra options for it.
+//
+// If you want to accept any value, use TakesAny.
+//
+// If you want more complex validation logic, use TakesMatching.
+func (d *ShadowingLocalTypesMockDescriptor) Takes(User_ User, opts ...cmp1.Option) ShadowingLocalTypesMockDescriptorWith1Arg {
+	prev := d.argValidator
+	d.argValidator = func(got_User_ User, got_Store_ *Store) []string {
+		errMsgs := prev(got_User_, got_Store_)
+		if diff := cmp1.Diff(User_, got_User_, opts...); diff != "" {
+			errMsgs = append(errMsgs, "parameter #1 mismatch:\n"+diff)
+		}
+		return errMsgs
+	}
+	return ShadowingLocalTypesMockDescriptorWith1Arg{d}
+}
+
+// TakesAny declares that any value passed to the mocked method
+// LocalTypes as parameter #1 is expected.
+func (d *ShadowingLocalTypesMockDescriptor) TakesAny() ShadowingLocalTypesMockDescriptorWith1Arg {
+	return ShadowingLocalTypesMockDescriptorWith1Arg{d}
+}
+
+// TakesMatching lets you pass a function to accept or reject the actual
+// value passed to the mocked method Shadowing.LocalTypes as parameter #1.
+func (d *ShadowingLocalTypesMockDescriptor) TakesMatching(match func(User_ User) error) ShadowingLocalTypesMockDescriptorWith1Arg {
+	prev := d.argValidator
+	d.argValidator = func(got_User_ User, got_Store_ *Store) []string {
+		errMsgs := prev(got_User_, got_Store_)
+		if err := match(got_User_); err != nil {
+			errMsgs = append(errMsgs, "parameter \"User_\" custom matcher error: "+err.Error())
+		}
+		return errMsgs
+	}
+	return ShadowingLocalTypesMockDescriptorWith1Arg{d}
+}
+
+// ShadowingLocalTypesMockDescriptorWith1Arg is a step forward in the description of a way that the
+// method Shadowing.LocalTypes is expected to be called, with 1
+// arguments specified.
+//
+// It has methods to describe the next argument, if there's any left, or the
+// return values otherwise.
+type ShadowingLocalTypesMockDescriptorWith1Arg struct {
+	methodDesc *ShadowingLocalTypesMockDescriptor
+}
+
+// And lets you specify a value with which the actual value passed to
+// the mocked method Shadowing.LocalTypes as parameter #2
+// will be compared.
+//
+// Package "github.com/google/go-cmp/cmp" is used to do the comparison. You can
+// pass extra options for it.
+//
+// If you want to accept any value, use AndAny.
+//
+// If you want more complex validation logic, use AndMatching.
+func (d ShadowingLocalTypesMockDescriptorWith1Arg) And(Store_ *Store, opts ...cmp1.Option) ShadowingMockDescribedCall {
+	prev := d.methodDesc.argValidator
+	d.methodDesc.argValidator = func(got_User_ User, got_Store_ *Store) []string {
+		errMsgs := prev(got_User_, got_Store_)
+		if diff := cmp1.Diff(Store_, got_Store_, opts...); diff != "" {
+			errMsgs = append(errMsgs, "parameter #2 mismatch:\n"+diff)
+		}
+		return errMsgs
+	}
+	return d.methodDesc.done()
+}
+
+// AndAny declares that any value passed to the mocked method
+// LocalTypes as parameter #2 is expected.
+func (d ShadowingLocalTypesMockDescriptorWith1Arg) AndAny() ShadowingMockDescribedCall {
+	return d.methodDesc.done()
+}
+
+// AndMatching lets you pass a function to accept or reject the actual
+// value passed to the mocked method Shadowing.LocalTypes as parameter #2.
+func (d ShadowingLocalTypesMockDescriptorWith1Arg) AndMatching(match func(Store_ *Store) error) ShadowingMockDescribedCall {
+	prev := d.methodDesc.argValidator
+	d.methodDesc.argValidator = func(got_User_ User, got_Store_ *Store) []string {
+		errMsgs := prev(got_User_, got_Store_)
+		if err := match(got_Store_); err != nil {
+			errMsgs = append(errMsgs, "parameter \"Store_\" custom matcher error: "+err.Error())
+		}
+		return errMsgs
+	}
+	return d.methodDesc.done()
+}
+
+// done ends the description, adding it to the mock descriptor.
+func (d *ShadowingLocalTypesMockDescriptor) done() ShadowingMockDescribedCall {
+	d.mockDesc.descriptors_LocalTypes = append(d.mockDesc.descriptors_LocalTypes, d)
+	return ShadowingMockDescribedCall{d.mockDesc, &d.times}
+}
+
+// Locals starts describing a way method Shadowing.Locals is expected to be called
+// and what it should return.
+//
+// You can call it several times to describe different behaviors, each matching different parameters.
+//
+// Shadowing.Locals is documented as follows.
+//
+// Locals are renamed, as they would shadow the generated code's local
+// variables.
+func (d ShadowingMockDescriptor) Locals() *ShadowingLocalsMockDescriptor {
+	return d.newShadowingLocalsMockDescriptor()
+}
+
+func (d ShadowingMockDescriptor) newShadowingLocalsMockDescriptor() *ShadowingLocalsMockDescriptor {
+	_, file, line, _ := runtime.Caller(2)
+	return &ShadowingLocalsMockDescriptor{
+		mockDesc:     d,
+		times:        func(int) error { return nil },
+		argValidator: func(got_prev_ int, got_desc_ string, got_matching_ bool, got_calls_ int) []string { return nil },
+		fileLine:     fmt1.Sprintf("%s:%d", file, line),
+	}
+}
+
+// ShadowingLocalsMockDescriptor is returned by ShadowingMockDescriptor.Locals and
+// holds methods to describe the mock for method Shadowing.Locals.
+type ShadowingLocalsMockDescriptor struct {
+	mockDesc     ShadowingMockDescriptor
+	times        func(int) error
+	argValidator func(got_prev_ int, got_desc_ string, got_matching_ bool, got_calls_ int) []string
+	call         func(prev_ int, desc_ string, matching_ bool, calls_ int) (d_ int, m_ int)
+	fileLine     string
+}
+
+// Takes lets you specify a value with which the actual value passed to
+// the mocked method Shadowing.Locals as parameter #1
+// will be compared.
+//
+// Package "github.com/google/go-cmp/cmp" is used to do the comparison. You can
+// pass extra options for it.
+//
+// If you want to accept any value, use TakesAny.
+//
+// If you want more complex validation logic, use TakesMatching.
+func (d *ShadowingLocalsMockDescriptor) Takes(prev_ int, opts ...cmp1.Option) ShadowingLocalsMockDescriptorWith1Arg {
+	prev := d.argValidator
+	d.argValidator = func(got_prev_ int, got_desc_ string, got_matching_ bool, got_calls_ int) []string {
+		errMsgs := prev(got_prev_, got_desc_, got_matching_, got_calls_)
+		if diff := cmp1.Diff(prev_, got_prev_, opts...); diff != "" {
+			errMsgs = append(errMsgs, "parameter #1 mismatch:\n"+diff)
+		}
+		return errMsgs
+	}
+	return ShadowingLocalsMockDescriptorWith1Arg{d}
+}
+
+// TakesAny declares that any value passed to the mocked method
+// Locals as parameter #1 is expected.
+func (d *ShadowingLocalsMockDescriptor) TakesAny() ShadowingLocalsMockDescriptorWith1Arg {
+	return ShadowingLocalsMockDescriptorWith1Arg{d}
+}
+
+// TakesMatching lets you pass a function to accept or reject the actual
+// value passed to the mocked method Shadowing.Locals as parameter #1.
+func (d *ShadowingLocalsMockDescriptor) TakesMatching(match func(prev_ int) error) ShadowingLocalsMockDescriptorWith1Arg {
+	prev := d.argValidator
+	d.argValidator = func(got_prev_ int, got_desc_ string, got_matching_ bool, got_calls_ int) []string {
+		errMsgs := prev(got_prev_, got_desc_, got_matching_, got_calls_)
+		if err := match(got_prev_); err != nil {
+			errMsgs = append(errMsgs, "parameter \"prev_\" custom matcher error: "+err.Error())
+		}
+		return errMsgs
+	}
+	return ShadowingLocalsMockDescriptorWith1Arg{d}
+}
+
+// ShadowingLocalsMockDescriptorWith1Arg is a step forward in the description of a way that the
+// method Shadowing.Locals is expected to be called, with 1
+// arguments specified.
+//
+// It has methods to describe the next argument, if there's any left, or the
+// return values otherwise.
+type ShadowingLocalsMockDescriptorWith1Arg struct {
+	methodDesc *ShadowingLocalsMockDescriptor
+}
+
+// And lets you specify a value with which the actual value passed to
+// the mocked method Shadowing.Locals as parameter #2
+// will be compared.
+//
+// Package "github.com/google/go-cmp/cmp" is used to do the comparison. You can
+// pass extra options for it.
+//
+// If you want to accept any value, use AndAny.
+//
+// If you want more complex validation logic, use AndMatching.
+func (d ShadowingLocalsMockDescriptorWith1Arg) And(desc_ string, opts ...cmp1.Option) ShadowingLocalsMockDescriptorWith2Args {
+	prev := d.methodDesc.argValidator
+	d.methodDesc.argValidator = func(got_prev_ int, got_desc_ string, got_matching_ bool, got_calls_ int) []string {
+		errMsgs := prev(got_prev_, got_desc_, got_matching_, got_calls_)
+		if diff := cmp1.Diff(desc_, got_desc_, opts...); diff != "" {
+			errMsgs = append(errMsgs, "parameter #2 mismatch:\n"+diff)
+		}
+		return errMsgs
+	}
+	return ShadowingLocalsMockDescriptorWith2Args{d.methodDesc}
+}
+
+// AndAny declares that any value passed to the mocked method
+// Locals as parameter #2 is expected.
+func (d ShadowingLocalsMockDescriptorWith1Arg) AndAny() ShadowingLocalsMockDescriptorWith2Args {
+	return ShadowingLocalsMockDescriptorWith2Args{d.methodDesc}
+}
+
+// AndMatching lets you pass a function to accept or reject the actual
+// value passed to the mocked method Shadowing.Locals as parameter #2.
+func (d ShadowingLocalsMockDescriptorWith1Arg) AndMatching(match func(desc_ string) error) ShadowingLocalsMockDescriptorWith2Args {
+	prev := d.methodDesc.argValidator
+	d.methodDesc.argValidator = func(got_prev_ int, got_desc_ string, got_matching_ bool, got_calls_ int) []string {
+		errMsgs := prev(got_prev_, got_desc_, got_matching_, got_calls_)
+		if err := match(got_desc_); err != nil {
+			errMsgs = append(errMsgs, "parameter \"desc_\" custom matcher error: "+err.Error())
+		}
+		return errMsgs
+	}
+	return ShadowingLocalsMockDescriptorWith2Args{d.methodDesc}
+}
+
+// ShadowingLocalsMockDescriptorWith2Args is a step forward in the description of a way that the
+// method Shadowing.Locals is expected to be called, with 2
+// arguments specified.
+//
+// It has methods to describe the next argument, if there's any left, or the
+// return values otherwise.
+type ShadowingLocalsMockDescriptorWith2Args struct {
+	methodDesc *ShadowingLocalsMockDescriptor
+}
+
+// And lets you specify a value with which the actual value passed to
+// the mocked method Shadowing.Locals as parameter #3
+// will be compared.
+//
+// Package "github.com/google/go-cmp/cmp" is used to do the comparison. You can
+// pass extra options for it.
+//
+// If you want to accept any value, use AndAny.
+//
+// If you want more complex validation logic, use AndMatching.
+func (d ShadowingLocalsMockDescriptorWith2Args) And(matching_ bool, opts ...cmp1.Option) ShadowingLocalsMockDescriptorWith3Args {
+	prev := d.methodDesc.argValidator
+	d.methodDesc.argValidator = func(got_prev_ int, got_desc_ string, got_matching_ bool, got_calls_ int) []string {
+		errMsgs := prev(got_prev_, got_desc_, got_matching_, got_calls_)
+		if diff := cmp1.Diff(matching_, got_matching_, opts...); diff != "" {
+			errMsgs = append(errMsgs, "parameter #3 mismatch:\n"+diff)
+		}
+		return errMsgs
+	}
+	return ShadowingLocalsMockDescriptorWith3Args{d.methodDesc}
+}
+
+// AndAny declares that any value passed to the mocked method
+// Locals as parameter #3 is expected.
+func (d ShadowingLocalsMockDescriptorWith2Args) AndAny() ShadowingLocalsMockDescriptorWith3Args {
+	return ShadowingLocalsMockDescriptorWith3Args{d.methodDesc}
+}
+
+// AndMatching lets you pass a function to accept or reject the actual
+// value passed to the mocked method Shadowing.Locals as parameter #3.
+func (d ShadowingLocalsMockDescriptorWith2Args) AndMatching(match func(matching_ bool) error) ShadowingLocalsMockDescriptorWith3Args {
+	prev := d.methodDesc.argValidator
+	d.methodDesc.argValidator = func(got_prev_ int, got_desc_ string, got_matching_ bool, got_calls_ int) []string {
+		errMsgs := prev(got_prev_, got_desc_, got_matching_, got_calls_)
+		if err := match(got_matching_); err != nil {
+			errMsgs = append(errMsgs, "parameter \"matching_\" custom matcher error: "+err.Error())
+		}
+		return errMsgs
+	}
+	return ShadowingLocalsMockDescriptorWith3Args{d.methodDesc}
+}
+
+// ShadowingLocalsMockDescriptorWith3Args is a step forward in the description of a way that the
+// method Shadowing.Locals is expected to be called, with 3
+// arguments specified.
+//
+// It has methods to describe the next argument, if there's any left, or the
+// return values otherwise.
+type ShadowingLocalsMockDescriptorWith3Args struct {
+	methodDesc *ShadowingLocalsMockDescriptor
+}
+
+// And lets you specify a value with which the actual value passed to
+// the mocked method Shadowing.Locals as parameter #4
+// will be compared.
+//
+// Package "github.com/google/go-cmp/cmp" is used to do the comparison. You can
+// pass extra options for it.
+//
+// If you want to accept any value, use AndAny.
+//
+// If you want more complex validation logic, use AndMatching.
+func (d ShadowingLocalsMockDescriptorWith3Args) And(calls_ int, opts ...cmp1.Option) ShadowingLocalsMockDescriptorWith4Args {
+	prev := d.methodDesc.argValidator
+	d.methodDesc.argValidator = func(got_prev_ int, got_desc_ string, got_matching_ bool, got_calls_ int) []string {
+		errMsgs := prev(got_prev_, got_desc_, got_matching_, got_calls_)
+		if diff := cmp1.Diff(calls_, got_calls_, opts...); diff != "" {
+			errMsgs = append(errMsgs, "parameter #4 mismatch:\n"+diff)
+		}
+		return errMsgs
+	}
+	return ShadowingLocalsMockDescriptorWith4Args{d.methodDesc}
+}
+
+// AndAny declares that any value passed to the mocked method
+// Locals as parameter #4 is expected.
+func (d ShadowingLocalsMockDescriptorWith3Args) AndAny() ShadowingLocalsMockDescriptorWith4Args {
+	return ShadowingLocalsMockDescriptorWith4Args{d.methodDesc}
+}
+
+// AndMatching lets you pass a function to accept or reject the actual
+// value passed to the mocked method Shadowing.Locals as parameter #4.
+func (d ShadowingLocalsMockDescriptorWith3Args) AndMatching(match func(calls_ int) error) ShadowingLocalsMockDescriptorWith4Args {
+	prev := d.methodDesc.argValidator
+	d.methodDesc.argValidator = func(got_prev_ int, got_desc_ string, got_matching_ bool, got_calls_ int) []string {
+		errMsgs := prev(got_prev_, got_desc_, got_matching_, got_calls_)
+		if err := match(got_calls_); err != nil {
+			errMsgs = append(errMsgs, "parameter \"calls_\" custom matcher error: "+err.Error())
+		}
+		return errMsgs
+	}
+	return ShadowingLocalsMockDescriptorWith4Args{d.methodDesc}
+}
+
+// ShadowingLocalsMockDescriptorWith4Args is a step forward in the description of a way that the
+// method Shadowing.Locals is expected to be called, with 4
+// arguments specified.
+//
+// It has methods to describe the next argument, if there's any left, or the
+// return values otherwise.
+type ShadowingLocalsMockDescriptorWith4Args struct {
+	methodDesc *ShadowingLocalsMockDescriptor
+}
+
+// Returns lets you specify the values that the mocked method Shadowing.Locals,
+// if called with values matching the expectations, will return.
+func (d ShadowingLocalsMockDescriptorWith4Args) Returns(d_ int, m_ int) ShadowingMockDescribedCall {
+	return d.ReturnsFrom(func(int, string, bool, int) (int, int) {
+		return d_, m_
+	})
+}
+
+// ReturnsFrom lets you specify the values that the mocked method Shadowing.Locals,
+// if called with values matching the expectations, will return.
+//
+// It passes such passed values to a function that then returns the return values.
+func (d ShadowingLocalsMockDescriptorWith4Args) ReturnsFrom(f func(prev_ int, desc_ string, matching_ bool, calls_ int) (d_ int, m_ int)) ShadowingMockDescribedCall {
+	d.methodDesc.call = f
+	return d.methodDesc.done()
+}
+
+// done ends the description, adding it to the mock descriptor.
+func (d *ShadowingLocalsMockDescriptor) done() ShadowingMockDescribedCall {
+	d.mockDesc.descriptors_Locals = append(d.mockDesc.descriptors_Locals, d)
+	return ShadowingMockDescribedCall{d.mockDesc, &d.times}
+}
+
+// Predeclared starts describing a way method Shadowing.Predeclared is expected to be called
+// and what it should return.
+//
+// You can call it several times to describe different behaviors, each matching different parameters.
+//
+// Shadowing.Predeclared is documented as follows.
+//
+// Predeclared are renamed, as they would shadow predeclared identifiers.
+func (d ShadowingMockDescriptor) Predeclared() *ShadowingPredeclaredMockDescriptor {
+	return d.newShadowingPredeclaredMockDescriptor()
+}
+
+func (d ShadowingMockDescriptor) newShadowingPredeclaredMockDescriptor() *ShadowingPredeclaredMockDescriptor {
+	_, file, line, _ := runtime.Caller(2)
+	return &ShadowingPredeclaredMockDescriptor{
+		mockDesc:     d,
+		times:        func(int) error { return nil },
+		argValidator: func(got_string_ string, got_len_ int, got_error_ bool) []string { return nil },
+		fileLine:     fmt1.Sprintf("%s:%d", file, line),
+	}
+}
+
+// ShadowingPredeclaredMockDescriptor is returned by ShadowingMockDescriptor.Predeclared and
+// holds methods to describe the mock for method Shadowing.Predeclared.
+type ShadowingPredeclaredMockDescriptor struct {
+	mockDesc     ShadowingMockDescriptor
+	times        func(int) error
+	argValidator func(got_string_ string, got_len_ int, got_error_ bool) []string
+	call         func(string_ string, len_ int, error_ bool) (true_ bool)
+	fileLine     string
+}
+
+// Takes lets you specify a value with which the actual value passed to
+// the mocked method Shadowing.Predeclared as parameter #1
+// will be compared.
+//
+// Package "github.com/google/go-cmp/cmp" is used to do the comparison. You can
+// pass extra options for it.
+//
+// If you want to accept any value, use TakesAny.
+//
+// If you want more complex validation logic, use TakesMatching.
+func (d *ShadowingPredeclaredMockDescriptor) Takes(string_ string, opts ...cmp1.Option) ShadowingPredeclaredMockDescriptorWith1Arg {
+	prev := d.argValidator
+	d.argValidator = func(got_string_ string, got_len_ int, got_error_ bool) []string {
+		errMsgs := prev(got_string_, got_len_, got_error_)
+		if diff := cmp1.Diff(string_, got_string_, opts...); diff != "" {
+			errMsgs = append(errMsgs, "parameter #1 mismatch:\n"+diff)
+		}
+		return errMsgs
+	}
+	return ShadowingPredeclaredMockDescriptorWith1Arg{d}
+}
+
+// TakesAny declares that any value passed to the mocked method
+// Predeclared as parameter #1 is expected.
+func (d *ShadowingPredeclaredMockDescriptor) TakesAny() ShadowingPredeclaredMockDescriptorWith1Arg {
+	return ShadowingPredeclaredMockDescriptorWith1Arg{d}
+}
+
+// TakesMatching lets you pass a function to accept or reject the actual
+// value passed to the mocked method Shadowing.Predeclared as parameter #1.
+func (d *ShadowingPredeclaredMockDescriptor) TakesMatching(match func(string_ string) error) ShadowingPredeclaredMockDescriptorWith1Arg {
+	prev := d.argValidator
+	d.argValidator = func(got_string_ string, got_len_ int, got_error_ bool) []string {
+		errMsgs := prev(got_string_, got_len_, got_error_)
+		if err := match(got_string_); err != nil {
+			errMsgs = append(errMsgs, "parameter \"string_\" custom matcher error: "+err.Error())
+		}
+		return errMsgs
+	}
+	return ShadowingPredeclaredMockDescriptorWith1Arg{d}
+}
+
+// ShadowingPredeclaredMockDescriptorWith1Arg is a step forward in the description of a way that the
+// method Shadowing.Predeclared is expected to be called, with 1
+// arguments specified.
+//
+// It has methods to describe the next argument, if there's any left, or the
+// return values otherwise.
+type ShadowingPredeclaredMockDescriptorWith1Arg struct {
+	methodDesc *ShadowingPredeclaredMockDescriptor
+}
+
+// And lets you specify a value with which the actual value passed to
+// the mocked method Shadowing.Predeclared as parameter #2
+// will be compared.
+//
+// Package "github.com/google/go-cmp/cmp" is used to do the comparison. You can
+// pass extra options for it.
+//
+// If you want to accept any value, use AndAny.
+//
+// If you want more complex validation logic, use AndMatching.
+func (d ShadowingPredeclaredMockDescriptorWith1Arg) And(len_ int, opts ...cmp1.Option) ShadowingPredeclaredMockDescriptorWith2Args {
+	prev := d.methodDesc.argValidator
+	d.methodDesc.argValidator = func(got_string_ string, got_len_ int, got_error_ bool) []string {
+		errMsgs := prev(got_string_, got_len_, got_error_)
+		if diff := cmp1.Diff(len_, got_len_, opts...); diff != "" {
+			errMsgs = append(errMsgs, "parameter #2 mismatch:\n"+diff)
+		}
+		return errMsgs
+	}
+	return ShadowingPredeclaredMockDescriptorWith2Args{d.methodDesc}
+}
+
+// AndAny declares that any value passed to the mocked method
+// Predeclared as parameter #2 is expected.
+func (d ShadowingPredeclaredMockDescriptorWith1Arg) AndAny() ShadowingPredeclaredMockDescriptorWith2Args {
+	return ShadowingPredeclaredMockDescriptorWith2Args{d.methodDesc}
+}
+
+// AndMatching lets you pass a function to accept or reject the actual
+// value passed to the mocked method Shadowing.Predeclared as parameter #2.
+func (d ShadowingPredeclaredMockDescriptorWith1Arg) AndMatching(match func(len_ int) error) ShadowingPredeclaredMockDescriptorWith2Args {
+	prev := d.methodDesc.argValidator
+	d.methodDesc.argValidator = func(got_string_ string, got_len_ int, got_error_ bool) []string {
+		errMsgs := prev(got_string_, got_len_, got_error_)
+		if err := match(got_len_); err != nil {
+			errMsgs = append(errMsgs, "parameter \"len_\" custom matcher error: "+err.Error())
+		}
+		return errMsgs
+	}
+	return ShadowingPredeclaredMockDescriptorWith2Args{d.methodDesc}
+}
+
+// ShadowingPredeclaredMockDescriptorWith2Args is a step forward in the description of a way that the
+// method Shadowing.Predeclared is expected to be called, with 2
+// arguments specified.
+//
+// It has methods to describe the next argument, if there's any left, or the
+// return values otherwise.
+type ShadowingPredeclaredMockDescriptorWith2Args struct {
+	methodDesc *ShadowingPredeclaredMockDescriptor
+}
+
+// And lets you specify a value with which the actual value passed to
+// the mocked method Shadowing.Predeclared as parameter #3
+// will be compared.
+//
+// Package "github.com/google/go-cmp/cmp" is used to do the comparison. You can
+// pass extra options for it.
+//
+// If you want to accept any value, use AndAny.
+//
+// If you want more complex validation logic, use AndMatching.
+func (d ShadowingPredeclaredMockDescriptorWith2Args) And(error_ bool, opts ...cmp1.Option) ShadowingPredeclaredMockDescriptorWith3Args {
+	prev := d.methodDesc.argValidator
+	d.methodDesc.argValidator = func(got_string_ string, got_len_ int, got_error_ bool) []string {
+		errMsgs := prev(got_string_, got_len_, got_error_)
+		if diff := cmp1.Diff(error_, got_error_, opts...); diff != "" {
+			errMsgs = append(errMsgs, "parameter #3 mismatch:\n"+diff)
+		}
+		return errMsgs
+	}
+	return ShadowingPredeclaredMockDescriptorWith3Args{d.methodDesc}
+}
+
+// AndAny declares that any value passed to the mocked method
+// Predeclared as parameter #3 is expected.
+func (d ShadowingPredeclaredMockDescriptorWith2Args) AndAny() ShadowingPredeclaredMockDescriptorWith3Args {
+	return ShadowingPredeclaredMockDescriptorWith3Args{d.methodDesc}
+}
+
+// AndMatching lets you pass a function to accept or reject the actual
+// value passed to the mocked method Shadowing.Predeclared as parameter #3.
+func (d ShadowingPredeclaredMockDescriptorWith2Args) AndMatching(match func(error_ bool) error) ShadowingPredeclaredMockDescriptorWith3Args {
+	prev := d.methodDesc.argValidator
+	d.methodDesc.argValidator = func(got_string_ string, got_len_ int, got_error_ bool) []string {
+		errMsgs := prev(got_string_, got_len_, got_error_)
+		if err := match(got_error_); err != nil {
+			errMsgs = append(errMsgs, "parameter \"error_\" custom matcher error: "+err.Error())
+		}
+		return errMsgs
+	}
+	return ShadowingPredeclaredMockDescriptorWith3Args{d.methodDesc}
+}
+
+// ShadowingPredeclaredMockDescriptorWith3Args is a step forward in the description of a way that the
+// method Shadowing.Predeclared is expected to be called, with 3
+// arguments specified.
+//
+// It has methods to describe the next argument, if there's any left, or the
+// return values otherwise.
+type ShadowingPredeclaredMockDescriptorWith3Args struct {
+	methodDesc *ShadowingPredeclaredMockDescriptor
+}
+
+// Returns lets you specify the values that the mocked method Shadowing.Predeclared,
+// if called with values matching the expectations, will return.
+func (d ShadowingPredeclaredMockDescriptorWith3Args) Returns(true_ bool) ShadowingMockDescribedCall {
+	return d.ReturnsFrom(func(string, int, bool) bool {
+		return true_
+	})
+}
+
+// ReturnsFrom lets you specify the values that the mocked method Shadowing.Predeclared,
+// if called with values matching the expectations, will return.
+//
+// It passes such passed values to a function that then returns the return values.
+func (d ShadowingPredeclaredMockDescriptorWith3Args) ReturnsFrom(f func(string_ string, len_ int, error_ bool) (true_ bool)) ShadowingMockDescribedCall {
+	d.methodDesc.call = f
+	return d.methodDesc.done()
+}
+
+// done ends the description, adding it to the mock descriptor.
+func (d *ShadowingPredeclaredMockDescriptor) done() ShadowingMockDescribedCall {
+	d.mockDesc.descriptors_Predeclared = append(d.mockDesc.descriptors_Predeclared, d)
+	return ShadowingMockDescribedCall{d.mockDesc, &d.times}
+}
+
+// Qualifiers starts describing a way method Shadowing.Qualifiers is expected to be called
+// and what it should return.
+//
+// You can call it several times to describe different behaviors, each matching different parameters.
+//
+// Shadowing.Qualifiers is documented as follows.
+//
+// Qualifiers are renamed, as they would shadow imported packages.
+func (d ShadowingMockDescriptor) Qualifiers() *ShadowingQualifiersMockDescriptor {
+	return d.newShadowingQualifiersMockDescriptor()
+}
+
+func (d ShadowingMockDescriptor) newShadowingQualifiersMockDescriptor() *ShadowingQualifiersMockDescriptor {
+	_, file, line, _ := runtime.Caller(2)
+	return &ShadowingQualifiersMockDescriptor{
+		mockDesc:     d,
+		times:        func(int) error { return nil },
+		argValidator: func(got_os_ *os.File, got_fmt_ string, got_cmp_ int) []string { return nil },
+		fileLine:     fmt1.Sprintf("%s:%d", file, line),
+	}
+}
+
+// ShadowingQualifiersMockDescriptor is returned by ShadowingMockDescriptor.Qualifiers and
+// holds methods to describe the mock for method Shadowing.Qualifiers.
+type ShadowingQualifiersMockDescriptor struct {
+	mockDesc     ShadowingMockDescriptor
+	times        func(int) error
+	argValidator func(got_os_ *os.File, got_fmt_ string, got_cmp_ int) []string
+	call         func(os_ *os.File, fmt_ string, cmp_ int)
+	fileLine     string
+}
+
+// Takes lets you specify a value with which the actual value passed to
+// the mocked method Shadowing.Qualifiers as parameter #1
+// will be compared.
+//
+// Package "github.com/google/go-cmp/cmp" is used to do the comparison. You can
+// pass extra options for it.
+//
+// If you want to accept any value, use TakesAny.
+//
+// If you want more complex validation logic, use TakesMatching.
+func (d *ShadowingQualifiersMockDescriptor) Takes(os_ *os.File, opts ...cmp1.Option) ShadowingQualifiersMockDescriptorWith1Arg {
+	prev := d.argValidator
+	d.argValidator = func(got_os_ *os.File, got_fmt_ string, got_cmp_ int) []string {
+		errMsgs := prev(got_os_, got_fmt_, got_cmp_)
+		if diff := cmp1.Diff(os_, got_os_, opts...); diff != "" {
+			errMsgs = append(errMsgs, "parameter #1 mismatch:\n"+diff)
+		}
+		return errMsgs
+	}
+	return ShadowingQualifiersMockDescriptorWith1Arg{d}
+}
+
+// TakesAny declares that any value passed to the mocked method
+// Qualifiers as parameter #1 is expected.
+func (d *ShadowingQualifiersMockDescriptor) TakesAny() ShadowingQualifiersMockDescriptorWith1Arg {
+	return ShadowingQualifiersMockDescriptorWith1Arg{d}
+}
+
+// TakesMatching lets you pass a function to accept or reject the actual
+// value passed to the mocked method Shadowing.Qualifiers as parameter #1.
+func (d *ShadowingQualifiersMockDescriptor) TakesMatching(match func(os_ *os.File) error) ShadowingQualifiersMockDescriptorWith1Arg {
+	prev := d.argValidator
+	d.argValidator = func(got_os_ *os.File, got_fmt_ string, got_cmp_ int) []string {
+		errMsgs := prev(got_os_, got_fmt_, got_cmp_)
+		if err := match(got_os_); err != nil {
+			errMsgs = append(errMsgs, "parameter \"os_\" custom matcher error: "+err.Error())
+		}
+		return errMsgs
+	}
+	return ShadowingQualifiersMockDescriptorWith1Arg{d}
+}
+
+// ShadowingQualifiersMockDescriptorWith1Arg is a step forward in the description of a way that the
+// method Shadowing.Qualifiers is expected to be called, with 1
+// arguments specified.
+//
+// It has methods to describe the next argument, if there's any left, or the
+// return values otherwise.
+type ShadowingQualifiersMockDescriptorWith1Arg struct {
+	methodDesc *ShadowingQualifiersMockDescriptor
+}
+
+// And lets you specify a value with which the actual value passed to
+// the mocked method Shadowing.Qualifiers as parameter #2
+// will be compared.
+//
+// Package "github.com/google/go-cmp/cmp" is used to do the comparison. You can
+// pass extra options for it.
+//
+// If you want to accept any value, use AndAny.
+//
+// If you want more complex validation logic, use AndMatching.
+func (d ShadowingQualifiersMockDescriptorWith1Arg) And(fmt_ string, opts ...cmp1.Option) ShadowingQualifiersMockDescriptorWith2Args {
+	prev := d.methodDesc.argValidator
+	d.methodDesc.argValidator = func(got_os_ *os.File, got_fmt_ string, got_cmp_ int) []string {
+		errMsgs := prev(got_os_, got_fmt_, got_cmp_)
+		if diff := cmp1.Diff(fmt_, got_fmt_, opts...); diff != "" {
+			errMsgs = append(errMsgs, "parameter #2 mismatch:\n"+diff)
+		}
+		return errMsgs
+	}
+	return ShadowingQualifiersMockDescriptorWith2Args{d.methodDesc}
+}
+
+// AndAny declares that any value passed to the mocked method
+// Qualifiers as parameter #2 is expected.
+func (d ShadowingQualifiersMockDescriptorWith1Arg) AndAny() ShadowingQualifiersMockDescriptorWith2Args {
+	return ShadowingQualifiersMockDescriptorWith2Args{d.methodDesc}
+}
+
+// AndMatching lets you pass a function to accept or reject the actual
+// value passed to the mocked method Shadowing.Qualifiers as parameter #2.
+func (d ShadowingQualifiersMockDescriptorWith1Arg) AndMatching(match func(fmt_ string) error) ShadowingQualifiersMockDescriptorWith2Args {
+	prev := d.methodDesc.argValidator
+	d.methodDesc.argValidator = func(got_os_ *os.File, got_fmt_ string, got_cmp_ int) []string {
+		errMsgs := prev(got_os_, got_fmt_, got_cmp_)
+		if err := match(got_fmt_); err != nil {
+			errMsgs = append(errMsgs, "parameter \"fmt_\" custom matcher error: "+err.Error())
+		}
+		return errMsgs
+	}
+	return ShadowingQualifiersMockDescriptorWith2Args{d.methodDesc}
+}
+
+// ShadowingQualifiersMockDescriptorWith2Args is a step forward in the description of a way that the
+// method Shadowing.Qualifiers is expected to be called, with 2
+// arguments specified.
+//
+// It has methods to describe the next argument, if there's any left, or the
+// return values otherwise.
+type ShadowingQualifiersMockDescriptorWith2Args struct {
+	methodDesc *ShadowingQualifiersMockDescriptor
+}
+
+// And lets you specify a value with which the actual value passed to
+// the mocked method Shadowing.Qualifiers as parameter #3
+// will be compared.
+//
+// Package "github.com/google/go-cmp/cmp" is used to do the comparison. You can
+// pass extra options for it.
+//
+// If you want to accept any value, use AndAny.
+//
+// If you want more complex validation logic, use AndMatching.
+func (d ShadowingQualifiersMockDescriptorWith2Args) And(cmp_ int, opts ...cmp1.Option) ShadowingMockDescribedCall {
+	prev := d.methodDesc.argValidator
+	d.methodDesc.argValidator = func(got_os_ *os.File, got_fmt_ string, got_cmp_ int) []string {
+		errMsgs := prev(got_os_, got_fmt_, got_cmp_)
+		if diff := cmp1.Diff(cmp_, got_cmp_, opts...); diff != "" {
+			errMsgs = append(errMsgs, "parameter #3 mismatch:\n"+diff)
+		}
+		return errMsgs
+	}
+	return d.methodDesc.done()
+}
+
+// AndAny declares that any value passed to the mocked method
+// Qualifiers as parameter #3 is expected.
+func (d ShadowingQualifiersMockDescriptorWith2Args) AndAny() ShadowingMockDescribedCall {
+	return d.methodDesc.done()
+}
+
+// AndMatching lets you pass a function to accept or reject the actual
+// value passed to the mocked method Shadowing.Qualifiers as parameter #3.
+func (d ShadowingQualifiersMockDescriptorWith2Args) AndMatching(match func(cmp_ int) error) ShadowingMockDescribedCall {
+	prev := d.methodDesc.argValidator
+	d.methodDesc.argValidator = func(got_os_ *os.File, got_fmt_ string, got_cmp_ int) []string {
+		errMsgs := prev(got_os_, got_fmt_, got_cmp_)
+		if err := match(got_cmp_); err != nil {
+			errMsgs = append(errMsgs, "parameter \"cmp_\" custom matcher error: "+err.Error())
+		}
+		return errMsgs
+	}
+	return d.methodDesc.done()
+}
+
+// done ends the description, adding it to the mock descriptor.
+func (d *ShadowingQualifiersMockDescriptor) done() ShadowingMockDescribedCall {
+	d.mockDesc.descriptors_Qualifiers = append(d.mockDesc.descriptors_Qualifiers, d)
+	return ShadowingMockDescribedCall{d.mockDesc, &d.times}
+}
+
+// Synthetic starts describing a way method Shadowing.Synthetic is expected to be called
+// and what it should return.
+//
+// You can call it several times to describe different behaviors, each matching different parameters.
+//
+// Shadowing.Synthetic is documented as follows.
+//
+// Synthetic names are renamed if they are already taken.
+func (d ShadowingMockDescriptor) Synthetic() *ShadowingSyntheticMockDescriptor {
+	return d.newShadowingSyntheticMockDescriptor()
+}
+
+func (d ShadowingMockDescriptor) newShadowingSyntheticMockDescriptor() *ShadowingSyntheticMockDescriptor {
+	_, file, line, _ := runtime.Caller(2)
+	return &ShadowingSyntheticMockDescriptor{
+		mockDesc:     d,
+		times:        func(int) error { return nil },
+		argValidator: func(got_a1 int, got_a1_ string, got_r0 bool) []string { return nil },
+		fileLine:     fmt1.Sprintf("%s:%d", file, line),
+	}
+}
+
+// ShadowingSyntheticMockDescriptor is returned by ShadowingMockDescriptor.Synthetic and
+// holds methods to describe the mock for method Shadowing.Synthetic.
+type ShadowingSyntheticMockDescriptor struct {
+	mockDesc     ShadowingMockDescriptor
+	times        func(int) error
+	argValidator func(got_a1 int, got_a1_ string, got_r0 bool) []string
+	call         func(a1 int, a1_ string, r0 bool) (r0_ int, r1 error)
+	fileLine     string
+}
+
+// Takes lets you specify a value with which the actual value passed to
+// the mocked method Shadowing.Synthetic as parameter #1
+// will be compared.
+//
+// Package "github.com/google/go-cmp/cmp" is used to do the comparison. You can
+// pass extra options for it.
+//
+// If you want to accept any value, use TakesAny.
+//
+// If you want more complex validation logic, use TakesMatching.
+func (d *ShadowingSyntheticMockDescriptor) Takes(a1 int, opts ...cmp1.Option) ShadowingSyntheticMockDescriptorWith1Arg {
+	prev := d.argValidator
+	d.argValidator = func(got_a1 int, got_a1_ string, got_r0 bool) []string {
+		errMsgs := prev(got_a1, got_a1_, got_r0)
+		if diff := cmp1.Diff(a1, got_a1, opts...); diff != "" {
+			errMsgs = append(errMsgs, "parameter #1 mismatch:\n"+diff)
+		}
+		return errMsgs
+	}
+	return ShadowingSyntheticMockDescriptorWith1Arg{d}
+}
+
+// TakesAny declares that any value passed to the mocked method
+// Synthetic as parameter #1 is expected.
+func (d *ShadowingSyntheticMockDescriptor) TakesAny() ShadowingSyntheticMockDescriptorWith1Arg {
+	return ShadowingSyntheticMockDescriptorWith1Arg{d}
+}
+
+// TakesMatching lets you pass a function to accept or reject the actual
+// value passed to the mocked method Shadowing.Synthetic as parameter #1.
+func (d *ShadowingSyntheticMockDescriptor) TakesMatching(match func(a1 int) error) ShadowingSyntheticMockDescriptorWith1Arg {
+	prev := d.argValidator
+	d.argValidator = func(got_a1 int, got_a1_ string, got_r0 bool) []string {
+		errMsgs := prev(got_a1, got_a1_, got_r0)
+		if err := match(got_a1); err != nil {
+			errMsgs = append(errMsgs, "parameter \"a1\" custom matcher error: "+err.Error())
+		}
+		return errMsgs
+	}
+	return ShadowingSyntheticMockDescriptorWith1Arg{d}
+}
+
+// ShadowingSyntheticMockDescriptorWith1Arg is a step forward in the description of a way that the
+// method Shadowing.Synthetic is expected to be called, with 1
+// arguments specified.
+//
+// It has methods to describe the next argument, if there's any left, or the
+// return values otherwise.
+type ShadowingSyntheticMockDescriptorWith1Arg struct {
+	methodDesc *ShadowingSyntheticMockDescriptor
+}
+
+// And lets you specify a value with which the actual value passed to
+// the mocked method Shadowing.Synthetic as parameter #2
+// will be compared.
+//
+// Package "github.com/google/go-cmp/cmp" is used to do the comparison. You can
+// pass extra options for it.
+//
+// If you want to accept any value, use AndAny.
+//
+// If you want more complex validation logic, use AndMatching.
+func (d ShadowingSyntheticMockDescriptorWith1Arg) And(a1_ string, opts ...cmp1.Option) ShadowingSyntheticMockDescriptorWith2Args {
+	prev := d.methodDesc.argValidator
+	d.methodDesc.argValidator = func(got_a1 int, got_a1_ string, got_r0 bool) []string {
+		errMsgs := prev(got_a1, got_a1_, got_r0)
+		if diff := cmp1.Diff(a1_, got_a1_, opts...); diff != "" {
+			errMsgs = append(errMsgs, "parameter #2 mismatch:\n"+diff)
+		}
+		return errMsgs
+	}
+	return ShadowingSyntheticMockDescriptorWith2Args{d.methodDesc}
+}
+
+// AndAny declares that any value passed to the mocked method
+// Synthetic as parameter #2 is expected.
+func (d ShadowingSyntheticMockDescriptorWith1Arg) AndAny() ShadowingSyntheticMockDescriptorWith2Args {
+	return ShadowingSyntheticMockDescriptorWith2Args{d.methodDesc}
+}
+
+// AndMatching lets you pass a function to accept or reject the actual
+// value passed to the mocked method Shadowing.Synthetic as parameter #2.
+func (d ShadowingSyntheticMockDescriptorWith1Arg) AndMatching(match func(a1_ string) error) ShadowingSyntheticMockDescriptorWith2Args {
+	prev := d.methodDesc.argValidator
+	d.methodDesc.argValidator = func(got_a1 int, got_a1_ string, got_r0 bool) []string {
+		errMsgs := prev(got_a1, got_a1_, got_r0)
+		if err := match(got_a1_); err != nil {
+			errMsgs = append(errMsgs, "parameter \"a1_\" custom matcher error: "+err.Error())
+		}
+		return errMsgs
+	}
+	return ShadowingSyntheticMockDescriptorWith2Args{d.methodDesc}
+}
+
+// ShadowingSyntheticMockDescriptorWith2Args is a step forward in the description of a way that the
+// method Shadowing.Synthetic is expected to be called, with 2
+// arguments specified.
+//
+// It has methods to describe the next argument, if there's any left, or the
+// return values otherwise.
+type ShadowingSyntheticMockDescriptorWith2Args struct {
+	methodDesc *ShadowingSyntheticMockDescriptor
+}
+
+// And lets you specify a value with which the actual value passed to
+// the mocked method Shadowing.Synthetic as parameter #3
+// will be compared.
+//
+// Package "github.com/google/go-cmp/cmp" is used to do the comparison. You can
+// pass extra options for it.
+//
+// If you want to accept any value, use AndAny.
+//
+// If you want more complex validation logic, use AndMatching.
+func (d ShadowingSyntheticMockDescriptorWith2Args) And(r0 bool, opts ...cmp1.Option) ShadowingSyntheticMockDescriptorWith3Args {
+	prev := d.methodDesc.argValidator
+	d.methodDesc.argValidator = func(got_a1 int, got_a1_ string, got_r0 bool) []string {
+		errMsgs := prev(got_a1, got_a1_, got_r0)
+		if diff := cmp1.Diff(r0, got_r0, opts...); diff != "" {
+			errMsgs = append(errMsgs, "parameter #3 mismatch:\n"+diff)
+		}
+		return errMsgs
+	}
+	return ShadowingSyntheticMockDescriptorWith3Args{d.methodDesc}
+}
+
+// AndAny declares that any value passed to the mocked method
+// Synthetic as parameter #3 is expected.
+func (d ShadowingSyntheticMockDescriptorWith2Args) AndAny() ShadowingSyntheticMockDescriptorWith3Args {
+	return ShadowingSyntheticMockDescriptorWith3Args{d.methodDesc}
+}
+
+// AndMatching lets you pass a function to accept or reject the actual
+// value passed to the mocked method Shadowing.Synthetic as parameter #3.
+func (d ShadowingSyntheticMockDescriptorWith2Args) AndMatching(match func(r0 bool) error) ShadowingSyntheticMockDescriptorWith3Args {
+	prev := d.methodDesc.argValidator
+	d.methodDesc.argValidator = func(got_a1 int, got_a1_ string, got_r0 bool) []string {
+		errMsgs := prev(got_a1, got_a1_, got_r0)
+		if err := match(got_r0); err != nil {
+			errMsgs = append(errMsgs, "parameter \"r0\" custom matcher error: "+err.Error())
+		}
+		return errMsgs
+	}
+	return ShadowingSyntheticMockDescriptorWith3Args{d.methodDesc}
+}
+
+// ShadowingSyntheticMockDescriptorWith3Args is a step forward in the description of a way that the
+// method Shadowing.Synthetic is expected to be called, with 3
+// arguments specified.
+//
+// It has methods to describe the next argument, if there's any left, or the
+// return values otherwise.
+type ShadowingSyntheticMockDescriptorWith3Args struct {
+	methodDesc *ShadowingSyntheticMockDescriptor
+}
+
+// Returns lets you specify the values that the mocked method Shadowing.Synthetic,
+// if called with values matching the expectations, will return.
+func (d ShadowingSyntheticMockDescriptorWith3Args) Returns(r0_ int, r1 error) ShadowingMockDescribedCall {
+	return d.ReturnsFrom(func(int, string, bool) (int, error) {
+		return r0_, r1
+	})
+}
+
+// ReturnsFrom lets you specify the values that the mocked method Shadowing.Synthetic,
+// if called with values matching the expectations, will return.
+//
+// It passes such passed values to a function that then returns the return values.
+func (d ShadowingSyntheticMockDescriptorWith3Args) ReturnsFrom(f func(a1 int, a1_ string, r0 bool) (r0_ int, r1 error)) ShadowingMockDescribedCall {
+	d.methodDesc.call = f
+	return d.methodDesc.done()
+}
+
+// done ends the description, adding it to the mock descriptor.
+func (d *ShadowingSyntheticMockDescriptor) done() ShadowingMockDescribedCall {
+	d.mockDesc.descriptors_Synthetic = append(d.mockDesc.descriptors_Synthetic, d)
+	return ShadowingMockDescribedCall{d.mockDesc, &d.times}
+}
+
+// Validator starts describing a way method Shadowing.Validator is expected to be called
+// and what it should return.
+//
+// You can call it several times to describe different behaviors, each matching different parameters.
+//
+// Shadowing.Validator is documented as follows.
+//
+// Validator's got_x is renamed, as it would clash with the name given to
+// x in argument validators.
+func (d ShadowingMockDescriptor) Validator() *ShadowingValidatorMockDescriptor {
+	return d.newShadowingValidatorMockDescriptor()
+}
+
+func (d ShadowingMockDescriptor) newShadowingValidatorMockDescriptor() *ShadowingValidatorMockDescriptor {
+	_, file, line, _ := runtime.Caller(2)
+	return &ShadowingValidatorMockDescriptor{
+		mockDesc:     d,
+		times:        func(int) error { return nil },
+		argValidator: func(got_a0 int, got_x int) []string { return nil },
+		fileLine:     fmt1.Sprintf("%s:%d", file, line),
+	}
+}
+
+// ShadowingValidatorMockDescriptor is returned by ShadowingMockDescriptor.Validator and
+// holds methods to describe the mock for method Shadowing.Validator.
+type ShadowingValidatorMockDescriptor struct {
+	mockDesc     ShadowingMockDescriptor
+	times        func(int) error
+	argValidator func(got_a0 int, got_x int) []string
+	call         func(a0 int, x int)
+	fileLine     string
+}
+
+// Takes lets you specify a value with which the actual value passed to
+// the mocked method Shadowing.Validator as parameter #1
+// will be compared.
+//
+// Package "github.com/google/go-cmp/cmp" is used to do the comparison. You can
+// pass extra options for it.
+//
+// If you want to accept any value, use TakesAny.
+//
+// If you want more complex validation logic, use TakesMatching.
+func (d *ShadowingValidatorMockDescriptor) Takes(a0 int, opts ...cmp1.Option) ShadowingValidatorMockDescriptorWith1Arg {
+	prev := d.argValidator
+	d.argValidator = func(got_a0 int, got_x int) []string {
+		errMsgs := prev(got_a0, got_x)
+		if diff := cmp1.Diff(a0, got_a0, opts...); diff != "" {
+			errMsgs = append(errMsgs, "parameter #1 mismatch:\n"+diff)
+		}
+		return errMsgs
+	}
+	return ShadowingValidatorMockDescriptorWith1Arg{d}
+}
+
+// TakesAny declares that any value passed to the mocked method
+// Validator as parameter #1 is expected.
+func (d *ShadowingValidatorMockDescriptor) TakesAny() ShadowingValidatorMockDescriptorWith1Arg {
+	return ShadowingValidatorMockDescriptorWith1Arg{d}
+}
+
+// TakesMatching lets you pass a function to accept or reject the actual
+// value passed to the mocked method Shadowing.Validator as parameter #1.
+func (d *ShadowingValidatorMockDescriptor) TakesMatching(match func(a0 int) error) ShadowingValidatorMockDescriptorWith1Arg {
+	prev := d.argValidator
+	d.argValidator = func(got_a0 int, got_x int) []string {
+		errMsgs := prev(got_a0, got_x)
+		if err := match(got_a0); err != nil {
+			errMsgs = append(errMsgs, "parameter \"a0\" custom matcher error: "+err.Error())
+		}
+		return errMsgs
+	}
+	return ShadowingValidatorMockDescriptorWith1Arg{d}
+}
+
+// ShadowingValidatorMockDescriptorWith1Arg is a step forward in the description of a way that the
+// method Shadowing.Validator is expected to be called, with 1
+// arguments specified.
+//
+// It has methods to describe the next argument, if there's any left, or the
+// return values otherwise.
+type ShadowingValidatorMockDescriptorWith1Arg struct {
+	methodDesc *ShadowingValidatorMockDescriptor
+}
+
+// And lets you specify a value with which the actual value passed to
+// the mocked method Shadowing.Validator as parameter #2
+// will be compared.
+//
+// Package "github.com/google/go-cmp/cmp" is used to do the comparison. You can
+// pass extra options for it.
+//
+// If you want to accept any value, use AndAny.
+//
+// If you want more complex validation logic, use AndMatching.
+func (d ShadowingValidatorMockDescriptorWith1Arg) And(x int, opts ...cmp1.Option) ShadowingMockDescribedCall {
+	prev := d.methodDesc.argValidator
+	d.methodDesc.argValidator = func(got_a0 int, got_x int) []string {
+		errMsgs := prev(got_a0, got_x)
+		if diff := cmp1.Diff(x, got_x, opts...); diff != "" {
+			errMsgs = append(errMsgs, "parameter #2 mismatch:\n"+diff)
+		}
+		return errMsgs
+	}
+	return d.methodDesc.done()
+}
+
+// AndAny declares that any value passed to the mocked method
+// Validator as parameter #2 is expected.
+func (d ShadowingValidatorMockDescriptorWith1Arg) AndAny() ShadowingMockDescribedCall {
+	return d.methodDesc.done()
+}
+
+// AndMatching lets you pass a function to accept or reject the actual
+// value passed to the mocked method Shadowing.Validator as parameter #2.
+func (d ShadowingValidatorMockDescriptorWith1Arg) AndMatching(match func(x int) error) ShadowingMockDescribedCall {
+	prev := d.methodDesc.argValidator
+	d.methodDesc.argValidator = func(got_a0 int, got_x int) []string {
+		errMsgs := prev(got_a0, got_x)
+		if err := match(got_x); err != nil {
+			errMsgs = append(errMsgs, "parameter \"x\" custom matcher error: "+err.Error())
+		}
+		return errMsgs
+	}
+	return d.methodDesc.done()
+}
+
+// done ends the description, adding it to the mock descriptor.
+func (d *ShadowingValidatorMockDescriptor) done() ShadowingMockDescribedCall {
+	d.mockDesc.descriptors_Validator = append(d.mockDesc.descriptors_Validator, d)
+	return ShadowingMockDescribedCall{d.mockDesc, &d.times}
+}
+
+// Mock returns a mock for Shadowing that calls the functions
+// defined as struct fields in the receiver.
+//
+// You probably want to use Describe instead.
+func (m *ShadowingMocker) Mock() ShadowingMock {
+	return _makegomock_ShadowingMockFromMocker{m}
+}
+
+type _makegomock_ShadowingMockFromMocker struct {
+	m *ShadowingMocker
+}
+
+func (m _makegomock_ShadowingMockFromMocker) Blanks(a0 int, a1 string) (r0 error) {
+	return m.m.Blanks(a0, a1)
+}
+
+func (m _makegomock_ShadowingMockFromMocker) Kept(i int, arg string, err error) {
+	m.m.Kept(i, arg, err)
+}
+
+func (m _makegomock_ShadowingMockFromMocker) LocalTypes(User_ User, Store_ *Store) {
+	m.m.LocalTypes(User_, Store_)
+}
+
+func (m _makegomock_ShadowingMockFromMocker) Locals(prev_ int, desc_ string, matching_ bool, calls_ int) (d_ int, m_ int) {
+	return m.m.Locals(prev_, desc_, matching_, calls_)
+}
+
+func (m _makegomock_ShadowingMockFromMocker) Predeclared(string_ string, len_ int, error_ bool) (true_ bool) {
+	return m.m.Predeclared(string_, len_, error_)
+}
+
+func (m _makegomock_ShadowingMockFromMocker) Qualifiers(os_ *os.File, fmt_ string, cmp_ int) {
+	m.m.Qualifiers(os_, fmt_, cmp_)
+}
+
+func (m _makegomock_ShadowingMockFromMocker) Synthetic(a1 int, a1_ string, r0 bool) (r0_ int, r1 error) {
+	return m.m.Synthetic(a1, a1_, r0)
+}
+
+func (m _makegomock_ShadowingMockFromMocker) Validator(a0 int, x int) {
+	m.m.Validator(a0, x)
+}
+
+// ShadowingMock is a mock with the same underlying type as Shadowing.
+//
+// It is copied from the original just to avoid introducing a dependency on
+// Shadowing's package.
+type ShadowingMock interface {
+	// Blanks get synthetic names.
+	Blanks(a0 int, a1 string) (r0 error)
+	// Kept are kept, since they don't clash with anything.
+	Kept(i int, arg string, err error)
+	// LocalTypes are renamed, as they would shadow types that the mock refers
+	// to unqualified, since it's in the same package.
+	LocalTypes(User_ User, Store_ *Store)
+	// Locals are renamed, as they would shadow the generated code's local
+	// variables.
+	Locals(prev_ int, desc_ string, matching_ bool, calls_ int) (d_ int, m_ int)
+	// Predeclared are renamed, as they would shadow predeclared identifiers.
+	Predeclared(string_ string, len_ int, error_ bool) (true_ bool)
+	// Qualifiers are renamed, as they would shadow imported packages.
+	Qualifiers(os_ *os.File, fmt_ string, cmp_ int)
+	// Synthetic names are renamed if they are already taken.
+	Synthetic(a1 int, a1_ string, r0 bool) (r0_ int, r1 error)
+	// Validator's got_x is renamed, as it would clash with the name given to
+	// x in argument validators.
+	Validator(a0 int, x int)
+}
+
+// ShadowingGenericMocker builds mocks for type ShadowingGeneric.
+//
+// Its fields match the original type's methods. Set those you expect to be
+// called, then call the Mock method to get a mock that implements the original
+// type.
+//
+// If the original type was a function, it is mapped to field Func.
+//
+// The Describe method is a shortcut to define this struct's fields in a
+// declarative manner.
+type ShadowingGenericMocker[T any] struct {
+	Get func(T_ T) (r0 T)
+}
+
+// Describe lets you describe how the methods on the resulting mock are expected
+// to be called and what they will return.
+//
+// When you're done describing methods, call Mock to get a mock that implements
+// the behavior you described.
+func (m *ShadowingGenericMocker[T]) Describe() ShadowingGenericMockDescriptor[T] {
+	return ShadowingGenericMockDescriptor[T]{m: m}
+}
+
+// A ShadowingGenericMockDescriptor lets you describe how the methods on the resulting mock are expected
+// to be called and what they will return.
+//
+// When you're done describing methods, call its Mock method to get a mock that
+// implements the behavior you described.
+type ShadowingGenericMockDescriptor[T any] struct {
+	m               *ShadowingGenericMocker[T]
+	descriptors_Get []*ShadowingGenericGetMockDescriptor[T]
+}
+
+// Mock returns a mock that the ShadowingGeneric interface, following the behavior
+// described by the descriptor methods.
+//
+// It also returns a function that should be called before the test is done to
+// ensure that the expected number of calls to the mock methods happened. You
+// can pass a *testing.T to it, since it implements the interface it wants.
+func (d ShadowingGenericMockDescriptor[T]) Mock() (m ShadowingGenericMock[T], assert func(t interface {
+	Errorf(s string, args ...interface{})
+}) (ok bool)) {
+	assert = d.done()
+	return d.m.Mock(), assert
+}
+
+func (d ShadowingGenericMockDescriptor[T]) done() func(t interface {
+	Errorf(s string, args ...interface{})
+}) bool {
+	var atAssert []func() (method string, errs []string)
+	type specErrs struct {
+		fileLine string
+		errs     []string
+	}
+
+	if len(d.descriptors_Get) > 0 {
+		for _, desc := range d.descriptors_Get {
+			desc := desc
+			calls := 0
+			prev := desc.call
+			desc.call = func(T_ T) (r0 T) {
+				calls++
+				return prev(T_)
+			}
+			atAssert = append(atAssert, func() (method string, errs []string) {
+				err := desc.times(calls)
+				if err != nil {
+					return "Get", []string{err.Error()}
+				}
+				return "", nil
+			})
+		}
+		d.m.Get = func(T_ T) (r0 T) {
+			var matching []*ShadowingGenericGetMockDescriptor[T]
+			var allErrs []specErrs
+			for _, desc := range d.descriptors_Get {
+				errs := desc.argValidator(T_)
+				if len(errs) > 0 {
+					allErrs = append(allErrs, specErrs{desc.fileLine, errs})
+				} else {
+					matching = append(matching, desc)
+				}
+			}
+			if len(matching) == 1 {
+				return matching[0].call(T_)
+			}
+			var args string
+			for i, arg := range []interface{}{T_} {
+				if i != 0 {
+					args += "\n\t"
+				}
+				args += fmt1.Sprintf("%#v", arg)
+			}
+			if len(matching) == 0 {
+				matchingErrs := ""
+				for _, errs := range allErrs {
+					matchingErrs += "\n\tcandidate described at " + errs.fileLine + ":\n"
+					for _, err := range errs.errs {
+						matchingErrs += "\n\t\t" + err
+					}
+				}
+				panic(fmt1.Errorf("no matching candidate for call to mock for ShadowingGeneric.Get with args:\n\n\t%+v\n\nfailing candidates:\n%s", args, matchingErrs))
+			}
+			matchingLines := ""
+			for _, m := range matching {
+				matchingLines += "\n\tcandidate described at " + m.fileLine
+			}
+			panic(fmt1.Errorf("more than one candidate for call to mock for ShadowingGeneric.Get with args:\n\n\t%+v\n\nmatching candidates:\n%s", args, matchingLines))
+		}
+	} else {
+		d.m.Get = func(T_ T) (r0 T) {
+			panic("unexpected call to mock for ShadowingGeneric.Get")
+		}
+	}
+	return func(t interface {
+		Errorf(s string, args ...interface{})
+	}) bool {
+		ok := true
+		for _, assert := range atAssert {
+			method, errs := assert()
+			for _, err := range errs {
+				ok = false
+				t.Errorf("mock for ShadowingGeneric.%s: %s", method, err)
+			}
+		}
+		return ok
+	}
+}
+
+// ShadowingGenericMockDescribedCall is the last step in the description of a way that a
+// method of ShadowingGeneric is to behave when called, with all expected parameters
+// and the resulting values specified.
+//
+// It has methods to describe the times the method is expected to be called.
+// Otherwise, through the embedded ShadowingGenericMockDescriptor, you can start
+// another method call description, or you can call Mock to end the description
+// and get the resulting mock.
+type ShadowingGenericMockDescribedCall[T any] struct {
+	ShadowingGenericMockDescriptor[T]
+	times *func(int) error
+}
+
+// Times lets you specify a exact number of times this method is expected to be
+// called.
+func (d ShadowingGenericMockDescribedCall[T]) Times(times int) ShadowingGenericMockDescriptor[T] {
+	return d.TimesMatching(func(got int) error {
+		if got != times {
+			return fmt1.Errorf("expected exactly %d calls, got %d", times, got)
+		}
+		return nil
+	})
+}
+
+// AtLeastTimes lets you specify a minimum number of times this method is expected to be
+// called.
+func (d ShadowingGenericMockDescribedCall[T]) AtLeastTimes(times int) ShadowingGenericMockDescriptor[T] {
+	return d.TimesMatching(func(got int) error {
+		if got < times {
+			return fmt1.Errorf("expected at least %d calls, got %d", times, got)
+		}
+		return nil
+	})
+}
+
+// TimesMatching lets you pass a function to accept or reject the number of times
+// this method has been called.
+func (d ShadowingGenericMockDescribedCall[T]) TimesMatching(f func(times int) error) ShadowingGenericMockDescriptor[T] {
+	*d.times = f
+	return d.ShadowingGenericMockDescriptor
+}
+
+// Get starts describing a way method ShadowingGeneric.Get is expected to be called
+// and what it should return.
+//
+// You can call it several times to describe different behaviors, each matching different parameters.
+func (d ShadowingGenericMockDescriptor[T]) Get() *ShadowingGenericGetMockDescriptor[T] {
+	return d.newShadowingGenericGetMockDescriptor()
+}
+
+func (d ShadowingGenericMockDescriptor[T]) newShadowingGenericGetMockDescriptor() *ShadowingGenericGetMockDescriptor[T] {
+	_, file, line, _ := runtime.Caller(2)
+	return &ShadowingGenericGetMockDescriptor[T]{
+		mockDesc:     d,
+		times:        func(int) error { return nil },
+		argValidator: func(got_T_ T) []string { return nil },
+		fileLine:     fmt1.Sprintf("%s:%d", file, line),
+	}
+}
+
+// ShadowingGenericGetMockDescriptor is returned by ShadowingGenericMockDescriptor.Get and
+// holds methods to describe the mock for method ShadowingGeneric.Get.
+type ShadowingGenericGetMockDescriptor[T any] struct {
+	mockDesc     ShadowingGenericMockDescriptor[T]
+	times        func(int) error
+	argValidator func(got_T_ T) []string
+	call         func(T_ T) (r0 T)
+	fileLine     string
+}
+
+// Takes lets you specify a value with which the actual value passed to
+// the mocked method ShadowingGeneric.Get as parameter #1
+// will be compared.
+//
+// Package "github.com/google/go-cmp/cmp" is used to do the comparison. You can
+// pass extra options for it.
+//
+// If you want to accept any value, use TakesAny.
+//
+// If you want more complex validation logic, use TakesMatching.
+func (d *ShadowingGenericGetMockDescriptor[T]) Takes(T_ T, opts ...cmp1.Option) ShadowingGenericGetMockDescriptorWith1Arg[T] {
+	prev := d.argValidator
+	d.argValidator = func(got_T_ T) []string {
+		errMsgs := prev(got_T_)
+		if diff := cmp1.Diff(T_, got_T_, opts...); diff != "" {
+			errMsgs = append(errMsgs, "parameter #1 mismatch:\n"+diff)
+		}
+		return errMsgs
+	}
+	return ShadowingGenericGetMockDescriptorWith1Arg[T]{d}
+}
+
+// TakesAny declares that any value passed to the mocked method
+// Get as parameter #1 is expected.
+func (d *ShadowingGenericGetMockDescriptor[T]) TakesAny() ShadowingGenericGetMockDescriptorWith1Arg[T] {
+	return ShadowingGenericGetMockDescriptorWith1Arg[T]{d}
+}
+
+// TakesMatching lets you pass a function to accept or reject the actual
+// value passed to the mocked method ShadowingGeneric.Get as parameter #1.
+func (d *ShadowingGenericGetMockDescriptor[T]) TakesMatching(match func(T_ T) error) ShadowingGenericGetMockDescriptorWith1Arg[T] {
+	prev := d.argValidator
+	d.argValidator = func(got_T_ T) []string {
+		errMsgs := prev(got_T_)
+		if err := match(got_T_); err != nil {
+			errMsgs = append(errMsgs, "parameter \"T_\" custom matcher error: "+err.Error())
+		}
+		return errMsgs
+	}
+	return ShadowingGenericGetMockDescriptorWith1Arg[T]{d}
+}
+
+// ShadowingGenericGetMockDescriptorWith1Arg is a step forward in the description of a way that the
+// method ShadowingGeneric.Get is expected to be called, with 1
+// arguments specified.
+//
+// It has methods to describe the next argument, if there's any left, or the
+// return values otherwise.
+type ShadowingGenericGetMockDescriptorWith1Arg[T any] struct {
+	methodDesc *ShadowingGenericGetMockDescriptor[T]
+}
+
+// Returns lets you specify the values that the mocked method ShadowingGeneric.Get,
+// if called with values matching the expectations, will return.
+func (d ShadowingGenericGetMockDescriptorWith1Arg[T]) Returns(r0 T) ShadowingGenericMockDescribedCall[T] {
+	return d.ReturnsFrom(func(T) T {
+		return r0
+	})
+}
+
+// ReturnsFrom lets you specify the values that the mocked method ShadowingGeneric.Get,
+// if called with values matching the expectations, will return.
+//
+// It passes such passed values to a function that then returns the return values.
+func (d ShadowingGenericGetMockDescriptorWith1Arg[T]) ReturnsFrom(f func(T_ T) (r0 T)) ShadowingGenericMockDescribedCall[T] {
+	d.methodDesc.call = f
+	return d.methodDesc.done()
+}
+
+// done ends the description, adding it to the mock descriptor.
+func (d *ShadowingGenericGetMockDescriptor[T]) done() ShadowingGenericMockDescribedCall[T] {
+	d.mockDesc.descriptors_Get = append(d.mockDesc.descriptors_Get, d)
+	return ShadowingGenericMockDescribedCall[T]{d.mockDesc, &d.times}
+}
+
+// Mock returns a mock for ShadowingGeneric that calls the functions
+// defined as struct fields in the receiver.
+//
+// You probably want to use Describe instead.
+func (m *ShadowingGenericMocker[T]) Mock() ShadowingGenericMock[T] {
+	return _makegomock_ShadowingGenericMockFromMocker[T]{m}
+}
+
+type _makegomock_ShadowingGenericMockFromMocker[T any] struct {
+	m *ShadowingGenericMocker[T]
+}
+
+func (m _makegomock_ShadowingGenericMockFromMocker[T]) Get(T_ T) (r0 T) {
+	return m.m.Get(T_)
+}
+
+// ShadowingGenericMock is a mock with the same underlying type as ShadowingGeneric.
+//
+// It is copied from the original just to avoid introducing a dependency on
+// ShadowingGeneric's package.
+type ShadowingGenericMock[T any] interface {
+	Get(T_ T) (r0 T)
+}
+
+// ShadowingTypeParamsMocker builds mocks for type ShadowingTypeParams.
+//
+// Its fields match the original type's methods. Set those you expect to be
+// called, then call the Mock method to get a mock that implements the original
+// type.
+//
+// If the original type was a function, it is mapped to field Func.
+//
+// The Describe method is a shortcut to define this struct's fields in a
+// declarative manner.
+type ShadowingTypeParamsMocker[fmt any, cmp comparable, mockrt any] struct {
+	Get func(key cmp) (r0 fmt, r1 mockrt)
+}
+
+// Describe lets you describe how the methods on the resulting mock are expected
+// to be called and what they will return.
+//
+// When you're done describing methods, call Mock to get a mock that implements
+// the behavior you described.
+func (m *ShadowingTypeParamsMocker[fmt, cmp, mockrt]) Describe() ShadowingTypeParamsMockDescriptor[fmt, cmp, mockrt] {
+	return ShadowingTypeParamsMockDescriptor[fmt, cmp, mockrt]{m: m}
+}
+
+// A ShadowingTypeParamsMockDescriptor lets you describe how the methods on the resulting mock are expected
+// to be called and what they will return.
+//
+// When you're done describing methods, call its Mock method to get a mock that
+// implements the behavior you described.
+type ShadowingTypeParamsMockDescriptor[fmt any, cmp comparable, mockrt any] struct {
+	m               *ShadowingTypeParamsMocker[fmt, cmp, mockrt]
+	descriptors_Get []*ShadowingTypeParamsGetMockDescriptor[fmt, cmp, mockrt]
+}
+
+// Mock returns a mock that the ShadowingTypeParams interface, following the behavior
+// described by the descriptor methods.
+//
+// It also returns a function that should be called before the test is done to
+// ensure that the expected number of calls to the mock methods happened. You
+// can pass a *testing.T to it, since it implements the interface it wants.
+func (d ShadowingTypeParamsMockDescriptor[fmt, cmp, mockrt]) Mock() (m ShadowingTypeParamsMock[fmt, cmp, mockrt], assert func(t interface {
+	Errorf(s string, args ...interface{})
+}) (ok bool)) {
+	assert = d.done()
+	return d.m.Mock(), assert
+}
+
+func (d ShadowingTypeParamsMockDescriptor[fmt, cmp, mockrt]) done() func(t interface {
+	Errorf(s string, args ...interface{})
+}) bool {
+	var atAssert []func() (method string, errs []string)
+	type specErrs struct {
+		fileLine string
+		errs     []string
+	}
+
+	if len(d.descriptors_Get) > 0 {
+		for _, desc := range d.descriptors_Get {
+			desc := desc
+			calls := 0
+			prev := desc.call
+			desc.call = func(key cmp) (r0 fmt, r1 mockrt) {
+				calls++
+				return prev(key)
+			}
+			atAssert = append(atAssert, func() (method string, errs []string) {
+				err := desc.times(calls)
+				if err != nil {
+					return "Get", []string{err.Error()}
+				}
+				return "", nil
+			})
+		}
+		d.m.Get = func(key cmp) (r0 fmt, r1 mockrt) {
+			var matching []*ShadowingTypeParamsGetMockDescriptor[fmt, cmp, mockrt]
+			var allErrs []specErrs
+			for _, desc := range d.descriptors_Get {
+				errs := desc.argValidator(key)
+				if len(errs) > 0 {
+					allErrs = append(allErrs, specErrs{desc.fileLine, errs})
+				} else {
+					matching = append(matching, desc)
+				}
+			}
+			if len(matching) == 1 {
+				return matching[0].call(key)
+			}
+			var args string
+			for i, arg := range []interface{}{key} {
+				if i != 0 {
+					args += "\n\t"
+				}
+				args += fmt1.Sprintf("%#v", arg)
+			}
+			if len(matching) == 0 {
+				matchingErrs := ""
+				for _, errs := range allErrs {
+					matchingErrs += "\n\tcandidate described at " + errs.fileLine + ":\n"
+					for _, err := range errs.errs {
+						matchingErrs += "\n\t\t" + err
+					}
+				}
+				panic(fmt1.Errorf("no matching candidate for call to mock for ShadowingTypeParams.Get with args:\n\n\t%+v\n\nfailing candidates:\n%s", args, matchingErrs))
+			}
+			matchingLines := ""
+			for _, m := range matching {
+				matchingLines += "\n\tcandidate described at " + m.fileLine
+			}
+			panic(fmt1.Errorf("more than one candidate for call to mock for ShadowingTypeParams.Get with args:\n\n\t%+v\n\nmatching candidates:\n%s", args, matchingLines))
+		}
+	} else {
+		d.m.Get = func(key cmp) (r0 fmt, r1 mockrt) {
+			panic("unexpected call to mock for ShadowingTypeParams.Get")
+		}
+	}
+	return func(t interface {
+		Errorf(s string, args ...interface{})
+	}) bool {
+		ok := true
+		for _, assert := range atAssert {
+			method, errs := assert()
+			for _, err := range errs {
+				ok = false
+				t.Errorf("mock for ShadowingTypeParams.%s: %s", method, err)
+			}
+		}
+		return ok
+	}
+}
+
+// ShadowingTypeParamsMockDescribedCall is the last step in the description of a way that a
+// method of ShadowingTypeParams is to behave when called, with all expected parameters
+// and the resulting values specified.
+//
+// It has methods to describe the times the method is expected to be called.
+// Otherwise, through the embedded ShadowingTypeParamsMockDescriptor, you can start
+// another method call description, or you can call Mock to end the description
+// and get the resulting mock.
+type ShadowingTypeParamsMockDescribedCall[fmt any, cmp comparable, mockrt any] struct {
+	ShadowingTypeParamsMockDescriptor[fmt, cmp, mockrt]
+	times *func(int) error
+}
+
+// Times lets you specify a exact number of times this method is expected to be
+// called.
+func (d ShadowingTypeParamsMockDescribedCall[fmt, cmp, mockrt]) Times(times int) ShadowingTypeParamsMockDescriptor[fmt, cmp, mockrt] {
+	return d.TimesMatching(func(got int) error {
+		if got != times {
+			return fmt1.Errorf("expected exactly %d calls, got %d", times, got)
+		}
+		return nil
+	})
+}
+
+// AtLeastTimes lets you specify a minimum number of times this method is expected to be
+// called.
+func (d ShadowingTypeParamsMockDescribedCall[fmt, cmp, mockrt]) AtLeastTimes(times int) ShadowingTypeParamsMockDescriptor[fmt, cmp, mockrt] {
+	return d.TimesMatching(func(got int) error {
+		if got < times {
+			return fmt1.Errorf("expected at least %d calls, got %d", times, got)
+		}
+		return nil
+	})
+}
+
+// TimesMatching lets you pass a function to accept or reject the number of times
+// this method has been called.
+func (d ShadowingTypeParamsMockDescribedCall[fmt, cmp, mockrt]) TimesMatching(f func(times int) error) ShadowingTypeParamsMockDescriptor[fmt, cmp, mockrt] {
+	*d.times = f
+	return d.ShadowingTypeParamsMockDescriptor
+}
+
+// Get starts describing a way method ShadowingTypeParams.Get is expected to be called
+// and what it should return.
+//
+// You can call it several times to describe different behaviors, each matching different parameters.
+func (d ShadowingTypeParamsMockDescriptor[fmt, cmp, mockrt]) Get() *ShadowingTypeParamsGetMockDescriptor[fmt, cmp, mockrt] {
+	return d.newShadowingTypeParamsGetMockDescriptor()
+}
+
+func (d ShadowingTypeParamsMockDescriptor[fmt, cmp, mockrt]) newShadowingTypeParamsGetMockDescriptor() *ShadowingTypeParamsGetMockDescriptor[fmt, cmp, mockrt] {
+	_, file, line, _ := runtime.Caller(2)
+	return &ShadowingTypeParamsGetMockDescriptor[fmt, cmp, mockrt]{
+		mockDesc:     d,
+		times:        func(int) error { return nil },
+		argValidator: func(got_key cmp) []string { return nil },
+		fileLine:     fmt1.Sprintf("%s:%d", file, line),
+	}
+}
+
+// ShadowingTypeParamsGetMockDescriptor is returned by ShadowingTypeParamsMockDescriptor.Get and
+// holds methods to describe the mock for method ShadowingTypeParams.Get.
+type ShadowingTypeParamsGetMockDescriptor[fmt any, cmp comparable, mockrt any] struct {
+	mockDesc     ShadowingTypeParamsMockDescriptor[fmt, cmp, mockrt]
+	times        func(int) error
+	argValidator func(got_key cmp) []string
+	call         func(key cmp) (r0 fmt, r1 mockrt)
+	fileLine     string
+}
+
+// Takes lets you specify a value with which the actual value passed to
+// the mocked method ShadowingTypeParams.Get as parameter #1
+// will be compared.
+//
+// Package "github.com/google/go-cmp/cmp" is used to do the comparison. You can
+// pass extra options for it.
+//
+// If you want to accept any value, use TakesAny.
+//
+// If you want more complex validation logic, use TakesMatching.
+func (d *ShadowingTypeParamsGetMockDescriptor[fmt, cmp, mockrt]) Takes(key cmp, opts ...cmp1.Option) ShadowingTypeParamsGetMockDescriptorWith1Arg[fmt, cmp, mockrt] {
+	prev := d.argValidator
+	d.argValidator = func(got_key cmp) []string {
+		errMsgs := prev(got_key)
+		if diff := cmp1.Diff(key, got_key, opts...); diff != "" {
+			errMsgs = append(errMsgs, "parameter #1 mismatch:\n"+diff)
+		}
+		return errMsgs
+	}
+	return ShadowingTypeParamsGetMockDescriptorWith1Arg[fmt, cmp, mockrt]{d}
+}
+
+// TakesAny declares that any value passed to the mocked method
+// Get as parameter #1 is expected.
+func (d *ShadowingTypeParamsGetMockDescriptor[fmt, cmp, mockrt]) TakesAny() ShadowingTypeParamsGetMockDescriptorWith1Arg[fmt, cmp, mockrt] {
+	return ShadowingTypeParamsGetMockDescriptorWith1Arg[fmt, cmp, mockrt]{d}
+}
+
+// TakesMatching lets you pass a function to accept or reject the actual
+// value passed to the mocked method ShadowingTypeParams.Get as parameter #1.
+func (d *ShadowingTypeParamsGetMockDescriptor[fmt, cmp, mockrt]) TakesMatching(match func(key cmp) error) ShadowingTypeParamsGetMockDescriptorWith1Arg[fmt, cmp, mockrt] {
+	prev := d.argValidator
+	d.argValidator = func(got_key cmp) []string {
+		errMsgs := prev(got_key)
+		if err := match(got_key); err != nil {
+			errMsgs = append(errMsgs, "parameter \"key\" custom matcher error: "+err.Error())
+		}
+		return errMsgs
+	}
+	return ShadowingTypeParamsGetMockDescriptorWith1Arg[fmt, cmp, mockrt]{d}
+}
+
+// ShadowingTypeParamsGetMockDescriptorWith1Arg is a step forward in the description of a way that the
+// method ShadowingTypeParams.Get is expected to be called, with 1
+// arguments specified.
+//
+// It has methods to describe the next argument, if there's any left, or the
+// return values otherwise.
+type ShadowingTypeParamsGetMockDescriptorWith1Arg[fmt any, cmp comparable, mockrt any] struct {
+	methodDesc *ShadowingTypeParamsGetMockDescriptor[fmt, cmp, mockrt]
+}
+
+// Returns lets you specify the values that the mocked method ShadowingTypeParams.Get,
+// if called with values matching the expectations, will return.
+func (d ShadowingTypeParamsGetMockDescriptorWith1Arg[fmt, cmp, mockrt]) Returns(r0 fmt, r1 mockrt) ShadowingTypeParamsMockDescribedCall[fmt, cmp, mockrt] {
+	return d.ReturnsFrom(func(cmp) (fmt, mockrt) {
+		return r0, r1
+	})
+}
+
+// ReturnsFrom lets you specify the values that the mocked method ShadowingTypeParams.Get,
+// if called with values matching the expectations, will return.
+//
+// It passes such passed values to a function that then returns the return values.
+func (d ShadowingTypeParamsGetMockDescriptorWith1Arg[fmt, cmp, mockrt]) ReturnsFrom(f func(key cmp) (r0 fmt, r1 mockrt)) ShadowingTypeParamsMockDescribedCall[fmt, cmp, mockrt] {
+	d.methodDesc.call = f
+	return d.methodDesc.done()
+}
+
+// done ends the description, adding it to the mock descriptor.
+func (d *ShadowingTypeParamsGetMockDescriptor[fmt, cmp, mockrt]) done() ShadowingTypeParamsMockDescribedCall[fmt, cmp, mockrt] {
+	d.mockDesc.descriptors_Get = append(d.mockDesc.descriptors_Get, d)
+	return ShadowingTypeParamsMockDescribedCall[fmt, cmp, mockrt]{d.mockDesc, &d.times}
+}
+
+// Mock returns a mock for ShadowingTypeParams that calls the functions
+// defined as struct fields in the receiver.
+//
+// You probably want to use Describe instead.
+func (m *ShadowingTypeParamsMocker[fmt, cmp, mockrt]) Mock() ShadowingTypeParamsMock[fmt, cmp, mockrt] {
+	return _makegomock_ShadowingTypeParamsMockFromMocker[fmt, cmp, mockrt]{m}
+}
+
+type _makegomock_ShadowingTypeParamsMockFromMocker[fmt any, cmp comparable, mockrt any] struct {
+	m *ShadowingTypeParamsMocker[fmt, cmp, mockrt]
+}
+
+func (m _makegomock_ShadowingTypeParamsMockFromMocker[fmt, cmp, mockrt]) Get(key cmp) (r0 fmt, r1 mockrt) {
+	return m.m.Get(key)
+}
+
+// ShadowingTypeParamsMock is a mock with the same underlying type as ShadowingTypeParams.
+//
+// It is copied from the original just to avoid introducing a dependency on
+// ShadowingTypeParams's package.
+type ShadowingTypeParamsMock[fmt any, cmp comparable, mockrt any] interface {
+	Get(key cmp) (r0 fmt, r1 mockrt)
+}
+
+// ShadowingInstallMocker builds mocks for type ShadowingInstall.
+//
+// Its fields match the original type's methods. Set those you expect to be
+// called, then call the Mock method to get a mock that implements the original
+// type.
+//
+// If the original type was a function, it is mapped to field Func.
+//
+// The Describe method is a shortcut to define this struct's fields in a
+// declarative manner.
+type ShadowingInstallMocker[t any, target any] struct {
+	// ShadowingInstall has type parameters named like the parameters of Install,
+	// which get other names instead.
+	Func func(a0 t) (r0 target)
+}
+
+// Describe lets you describe how the methods on the resulting mock are expected
+// to be called and what they will return.
+//
+// When you're done describing methods, call Mock to get a mock that implements
+// the behavior you described.
+func (m *ShadowingInstallMocker[t, target]) Describe() ShadowingInstallMockDescriptor[t, target] {
+	return ShadowingInstallMockDescriptor[t, target]{m: m}
+}
+
+// A ShadowingInstallMockDescriptor lets you describe how the methods on the resulting mock are expected
+// to be called and what they will return.
+//
+// When you're done describing methods, call its Mock method to get a mock that
+// implements the behavior you described.
+type ShadowingInstallMockDescriptor[t any, target any] struct {
+	m                *ShadowingInstallMocker[t, target]
+	descriptors_Func []*ShadowingInstallFuncMockDescriptor[t, target]
+}
+
+// Mock returns a mock that the ShadowingInstall interface, following the behavior
+// described by the descriptor methods.
+//
+// It also returns a function that should be called before the test is done to
+// ensure that the expected number of calls to the mock methods happened. You
+// can pass a *testing.T to it, since it implements the interface it wants.
+func (d ShadowingInstallMockDescriptor[t, target]) Mock() (m ShadowingInstallMock[t, target], assert func(t interface {
+	Errorf(s string, args ...interface{})
+}) (ok bool)) {
+	assert = d.done()
+	return d.m.Mock(), assert
+}
+
+func (d ShadowingInstallMockDescriptor[t, target]) done() func(t interface {
+	Errorf(s string, args ...interface{})
+}) bool {
+	var atAssert []func() (method string, errs []string)
+	type specErrs struct {
+		fileLine string
+		errs     []string
+	}
+
+	if len(d.descriptors_Func) > 0 {
+		for _, desc := range d.descriptors_Func {
+			desc := desc
+			calls := 0
+			prev := desc.call
+			desc.call = func(a0 t) (r0 target) {
+				calls++
+				return prev(a0)
+			}
+			atAssert = append(atAssert, func() (method string, errs []string) {
+				err := desc.times(calls)
+				if err != nil {
+					return "Func", []string{err.Error()}
+				}
+				return "", nil
+			})
+		}
+		d.m.Func = func(a0 t) (r0 target) {
+			var matching []*ShadowingInstallFuncMockDescriptor[t, target]
+			var allErrs []specErrs
+			for _, desc := range d.descriptors_Func {
+				errs := desc.argValidator(a0)
+				if len(errs) > 0 {
+					allErrs = append(allErrs, specErrs{desc.fileLine, errs})
+				} else {
+					matching = append(matching, desc)
+				}
+			}
+			if len(matching) == 1 {
+				return matching[0].call(a0)
+			}
+			var args string
+			for i, arg := range []interface{}{a0} {
+				if i != 0 {
+					args += "\n\t"
+				}
+				args += fmt1.Sprintf("%#v", arg)
+			}
+			if len(matching) == 0 {
+				matchingErrs := ""
+				for _, errs := range allErrs {
+					matchingErrs += "\n\tcandidate described at " + errs.fileLine + ":\n"
+					for _, err := range errs.errs {
+						matchingErrs += "\n\t\t" + err
+					}
+				}
+				panic(fmt1.Errorf("no matching candidate for call to mock for ShadowingInstall.Func with args:\n\n\t%+v\n\nfailing candidates:\n%s", args, matchingErrs))
+			}
+			matchingLines := ""
+			for _, m := range matching {
+				matchingLines += "\n\tcandidate described at " + m.fileLine
+			}
+			panic(fmt1.Errorf("more than one candidate for call to mock for ShadowingInstall.Func with args:\n\n\t%+v\n\nmatching candidates:\n%s", args, matchingLines))
+		}
+	} else {
+		d.m.Func = func(a0 t) (r0 target) {
+			panic("unexpected call to mock for ShadowingInstall.Func")
+		}
+	}
+	return func(t interface {
+		Errorf(s string, args ...interface{})
+	}) bool {
+		ok := true
+		for _, assert := range atAssert {
+			method, errs := assert()
+			for _, err := range errs {
+				ok = false
+				t.Errorf("mock for ShadowingInstall.%s: %s", method, err)
+			}
+		}
+		return ok
+	}
+}
+
+// Install sets *target_ to the mock, as Mock returns it, until the test that t_
+// belongs to finishes. Then, it restores the previous value of *target_, and
+// asserts that the mock was called as expected. You can pass a *testing.T to
+// it.
+//
+// It's meant for package-level variables that the code under test calls, like
+// var now = time.Now, which would otherwise need to be saved and restored by
+// hand.
+//
+// It panics if a ShadowingInstall mock is already installed into target_, as it
+// would happen if tests installing it ran in parallel.
+func (d ShadowingInstallMockDescriptor[t, target]) Install(target_ *ShadowingInstall[t, target], t_ interface {
+	Cleanup(func())
+	Errorf(s string, args ...interface{})
+}) {
+	if _, loaded := _makegomock_ShadowingInstallInstalled.LoadOrStore(target_, struct{}{}); loaded {
+		panic("a mock for ShadowingInstall is already installed into the target variable; tests installing it can't run in parallel")
+	}
+	m, assert := d.Mock()
+	prev := *target_
+	*target_ = ShadowingInstall[t, target](m)
+	t_.Cleanup(func() {
+		*target_ = prev
+		_makegomock_ShadowingInstallInstalled.Delete(target_)
+		assert(t_)
+	})
+}
+
+// _makegomock_ShadowingInstallInstalled holds the variables that a ShadowingInstall mock is installed into.
+var _makegomock_ShadowingInstallInstalled sync.Map
+
+// ShadowingInstallMockDescribedCall is the last step in the description of a way that a
+// method of ShadowingInstall is to behave when called, with all expected parameters
+// and the resulting values specified.
+//
+// It has methods to describe the times the method is expected to be called.
+// Otherwise, through the embedded ShadowingInstallMockDescriptor, you can start
+// another method call description, or you can call Mock to end the description
+// and get the resulting mock.
+type ShadowingInstallMockDescribedCall[t any, target any] struct {
+	ShadowingInstallMockDescriptor[t, target]
+	times *func(int) error
+}
+
+// Times lets you specify a exact number of times this method is expected to be
+// called.
+func (d ShadowingInstallMockDescribedCall[t, target]) Times(times int) ShadowingInstallMockDescriptor[t, target] {
+	return d.TimesMatching(func(got int) error {
+		if got != times {
+			return fmt1.Errorf("expected exactly %d calls, got %d", times, got)
+		}
+		return nil
+	})
+}
+
+// AtLeastTimes lets you specify a minimum number of times this method is expected to be
+// called.
+func (d ShadowingInstallMockDescribedCall[t, target]) AtLeastTimes(times int) ShadowingInstallMockDescriptor[t, target] {
+	return d.TimesMatching(func(got int) error {
+		if got < times {
+			return fmt1.Errorf("expected at least %d calls, got %d", times, got)
+		}
+		return nil
+	})
+}
+
+// TimesMatching lets you pass a function to accept or reject the number of times
+// this method has been called.
+func (d ShadowingInstallMockDescribedCall[t, target]) TimesMatching(f func(times int) error) ShadowingInstallMockDescriptor[t, target] {
+	*d.times = f
+	return d.ShadowingInstallMockDescriptor
+}
+
+// Func starts describing a way method ShadowingInstall.Func is expected to be called
+// and what it should return.
+//
+// You can call it several times to describe different behaviors, each matching different parameters.
+//
+// ShadowingInstall.Func is documented as follows.
+//
+// ShadowingInstall has type parameters named like the parameters of Install,
+// which get other names instead.
+func (d ShadowingInstallMockDescriptor[t, target]) Func() *ShadowingInstallFuncMockDescriptor[t, target] {
+	return d.newShadowingInstallFuncMockDescriptor()
+}
+
+func (d ShadowingInstallMockDescriptor[t, target]) newShadowingInstallFuncMockDescriptor() *ShadowingInstallFuncMockDescriptor[t, target] {
+	_, file, line, _ := runtime.Caller(2)
+	return &ShadowingInstallFuncMockDescriptor[t, target]{
+		mockDesc:     d,
+		times:        func(int) error { return nil },
+		argValidator: func(got_a0 t) []string { return nil },
+		fileLine:     fmt1.Sprintf("%s:%d", file, line),
+	}
+}
+
+// ShadowingInstallFuncMockDescriptor is returned by ShadowingInstallMockDescriptor.Func and
+// holds methods to describe the mock for method ShadowingInstall.Func.
+type ShadowingInstallFuncMockDescriptor[t any, target any] struct {
+	mockDesc     ShadowingInstallMockDescriptor[t, target]
+	times        func(int) error
+	argValidator func(got_a0 t) []string
+	call         func(a0 t) (r0 target)
+	fileLine     string
+}
+
+// Takes lets you specify a value with which the actual value passed to
+// the mocked method ShadowingInstall.Func as parameter #1
+// will be compared.
+//
+// Package "github.com/google/go-cmp/cmp" is used to do the comparison. You can
+// pass extra options for it.
+//
+// If you want to accept any value, use TakesAny.
+//
+// If you want more complex validation logic, use TakesMatching.
+func (d *ShadowingInstallFuncMockDescriptor[t, target]) Takes(a0 t, opts ...cmp1.Option) ShadowingInstallFuncMockDescriptorWith1Arg[t, target] {
+	prev := d.argValidator
+	d.argValidator = func(got_a0 t) []string {
+		errMsgs := prev(got_a0)
+		if diff := cmp1.Diff(a0, got_a0, opts...); diff != "" {
+			errMsgs = append(errMsgs, "parameter #1 mismatch:\n"+diff)
+		}
+		return errMsgs
+	}
+	return ShadowingInstallFuncMockDescriptorWith1Arg[t, target]{d}
+}
+
+// TakesAny declares that any value passed to the mocked method
+// Func as parameter #1 is expected.
+func (d *ShadowingInstallFuncMockDescriptor[t, target]) TakesAny() ShadowingInstallFuncMockDescriptorWith1Arg[t, target] {
+	return ShadowingInstallFuncMockDescriptorWith1Arg[t, target]{d}
+}
+
+// TakesMatching lets you pass a function to accept or reject the actual
+// value passed to the mocked method ShadowingInstall.Func as parameter #1.
+func (d *ShadowingInstallFuncMockDescriptor[t, target]) TakesMatching(match func(a0 t) error) ShadowingInstallFuncMockDescriptorWith1Arg[t, target] {
+	prev := d.argValidator
+	d.argValidator = func(got_a0 t) []string {
+		errMsgs := prev(got_a0)
+		if err := match(got_a0); err != nil {
+			errMsgs = append(errMsgs, "parameter \"a0\" custom matcher error: "+err.Error())
+		}
+		return errMsgs
+	}
+	return ShadowingInstallFuncMockDescriptorWith1Arg[t, target]{d}
+}
+
+// ShadowingInstallFuncMockDescriptorWith1Arg is a step forward in the description of a way that the
+// method ShadowingInstall.Func is expected to be called, with 1
+// arguments specified.
+//
+// It has methods to describe the next argument, if there's any left, or the
+// return values otherwise.
+type ShadowingInstallFuncMockDescriptorWith1Arg[t any, target any] struct {
+	methodDesc *ShadowingInstallFuncMockDescriptor[t, target]
+}
+
+// Returns lets you specify the values that the mocked method ShadowingInstall.Func,
+// if called with values matching the expectations, will return.
+func (d ShadowingInstallFuncMockDescriptorWith1Arg[t, target]) Returns(r0 target) ShadowingInstallMockDescribedCall[t, target] {
+	return d.ReturnsFrom(func(t) target {
+		return r0
+	})
+}
+
+// ReturnsFrom lets you specify the values that the mocked method ShadowingInstall.Func,
+// if called with values matching the expectations, will return.
+//
+// It passes such passed values to a function that then returns the return values.
+func (d ShadowingInstallFuncMockDescriptorWith1Arg[t, target]) ReturnsFrom(f func(a0 t) (r0 target)) ShadowingInstallMockDescribedCall[t, target] {
+	d.methodDesc.call = f
+	return d.methodDesc.done()
+}
+
+// done ends the description, adding it to the mock descriptor.
+func (d *ShadowingInstallFuncMockDescriptor[t, target]) done() ShadowingInstallMockDescribedCall[t, target] {
+	d.mockDesc.descriptors_Func = append(d.mockDesc.descriptors_Func, d)
+	return ShadowingInstallMockDescribedCall[t, target]{d.mockDesc, &d.times}
+}
+
+// Mock returns a mock for ShadowingInstall that calls the functions
+// defined as struct fields in the receiver.
+//
+// You probably want to use Describe instead.
+func (m *ShadowingInstallMocker[t, target]) Mock() ShadowingInstallMock[t, target] {
+	return m.Func
+}
+
+// ShadowingInstallMock is a mock with the same underlying type as ShadowingInstall.
+//
+// It is copied from the original just to avoid introducing a dependency on
+// ShadowingInstall's package.
+type ShadowingInstallMock[t any, target any] func(t) target
@@ -0,0 +1,97 @@
+package examples
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRuntimeMatches(t *testing.T) {
+	m := map[string]map[MyStruct]bool{}
+	expectedErr := errors.New("expected")
+	mock, assertMock := (&MyInterfaceWithRuntimeMocker{}).Describe().
+		ShouldBeFun().Takes(123).And(m).AndAny().Returns(1, expectedErr).Times(1).
+		ShouldBeFun().Takes(456).And(m).AndAny().Returns(2, nil).AtLeastTimes(1).
+		Boring().Times(1).
+		Mock()
+	defer assertMock(t)
+
+	gotInt, gotErr := mock.ShouldBeFun(123, m)
+	assert.Equal(t, 1, gotInt)
+	assert.Equal(t, expectedErr, gotErr)
+
+	gotInt, gotErr = mock.ShouldBeFun(456, m)
+	assert.Equal(t, 2, gotInt)
+	assert.Nil(t, gotErr)
+
+	mock.Boring()
+}
+
+func TestRuntimeNoMatches(t *testing.T) {
+	m := map[string]map[MyStruct]bool{}
+	mock, _ := (&MyInterfaceWithRuntimeMocker{}).Describe().
+		ShouldBeFun().Takes(123).And(m).AndAny().Returns(1, nil).
+		Mock()
+
+	err := recoverError(func() {
+		mock.ShouldBeFun(789, m)
+	})
+	assert.Contains(t, err, "no matching candidate for call to mock for MyInterfaceWithRuntime.ShouldBeFun with args:\n\n\t789\n")
+	assert.Contains(t, err, "runtime_test.go:")
+	assert.Contains(t, err, "parameter #1 mismatch:")
+}
+
+func TestRuntimeTooManyMatches(t *testing.T) {
+	m := map[string]map[MyStruct]bool{}
+	mock, _ := (&MyInterfaceWithRuntimeMocker{}).Describe().
+		ShouldBeFun().TakesAny().And(m).AndAny().Returns(1, nil).
+		ShouldBeFun().Takes(456).And(m).AndAny().Returns(2, nil).
+		Mock()
+
+	err := recoverError(func() {
+		mock.ShouldBeFun(456, m)
+	})
+	assert.Contains(t, err, "more than one candidate for call to mock for MyInterfaceWithRuntime.ShouldBeFun with args:")
+}
+
+func TestRuntimeUnexpected(t *testing.T) {
+	mock, _ := (&MyInterfaceWithRuntimeMocker{}).Describe().Mock()
+
+	assert.PanicsWithValue(t, "unexpected call to mock for MyInterfaceWithRuntime.Boring", func() {
+		mock.Boring()
+	})
+}
+
+func TestRuntimeTimesFail(t *testing.T) {
+	mock, assertMock := (&RepositoryWithRuntimeMocker[string, int]{}).Describe().
+		Get().Takes("foo").Returns(42, nil).Times(2).
+		Keys().Returns(nil).AtLeastTimes(1).
+		Mock()
+
+	v, err := mock.Get("foo")
+	assert.Equal(t, 42, v)
+	assert.NoError(t, err)
+
+	var errs errorsRecorder
+	assert.False(t, assertMock(&errs))
+	assert.Equal(t, errorsRecorder{
+		"mock for RepositoryWithRuntime.Get: expected exactly 2 calls, got 1",
+		"mock for RepositoryWithRuntime.Keys: expected at least 1 calls, got 0",
+	}, errs)
+}
+
+type errorsRecorder []string
+
+func (r *errorsRecorder) Errorf(s string, args ...interface{}) {
+	*r = append(*r, fmt.Sprintf(s, args...))
+}
+
+func recoverError(f func()) (msg string) {
+	defer func() {
+		msg = fmt.Sprint(recover())
+	}()
+	f()
+	return ""
+}
@@ -0,0 +1,83 @@
+package examples
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShadowingParamNames(t *testing.T) {
+	expectedErr := errors.New("expected")
+	mock, assertMock := (&ShadowingMocker{}).Describe().
+		Locals().Takes(1).And("desc").And(true).And(2).Returns(3, 4).Times(1).
+		Blanks().Takes(5).And("blank").Returns(expectedErr).Times(1).
+		Predeclared().Takes("string").And(6).And(false).Returns(true).Times(1).
+		Qualifiers().Takes(nil).And("fmt").And(7).Times(1).
+		Validator().Takes(8).And(9).Times(1).
+		Synthetic().Takes(10).And("synthetic").And(true).Returns(11, nil).Times(1).
+		LocalTypes().Takes(User{ID: "13"}).And(nil).Times(1).
+		Kept().Takes(12).And("arg").And(expectedErr, cmpopts.EquateErrors()).Times(1).
+		Mock()
+	defer assertMock(t)
+
+	d, m := mock.Locals(1, "desc", true, 2)
+	assert.Equal(t, 3, d)
+	assert.Equal(t, 4, m)
+	assert.Equal(t, expectedErr, mock.Blanks(5, "blank"))
+	assert.True(t, mock.Predeclared("string", 6, false))
+	mock.Qualifiers(nil, "fmt", 7)
+	mock.Validator(8, 9)
+	n, err := mock.Synthetic(10, "synthetic", true)
+	assert.Equal(t, 11, n)
+	assert.NoError(t, err)
+	mock.LocalTypes(User{ID: "13"}, nil)
+	mock.Kept(12, "arg", expectedErr)
+
+	assert.Panics(t, func() { mock.Validator(9, 8) })
+}
+
+func TestShadowingTypeParamName(t *testing.T) {
+	mock, assertMock := (&ShadowingGenericMocker[string]{}).Describe().
+		Get().Takes("in").Returns("out").Times(1).
+		Mock()
+	defer assertMock(t)
+
+	assert.Equal(t, "out", mock.Get("in"))
+}
+
+func TestShadowingImportsWithTypeParams(t *testing.T) {
+	mock, assertMock := (&ShadowingTypeParamsMocker[string, int, bool]{}).Describe().
+		Get().Takes(1).Returns("out", true).Times(1).
+		Mock()
+	defer assertMock(t)
+
+	out, ok := mock.Get(1)
+	assert.Equal(t, "out", out)
+	assert.True(t, ok)
+	assert.Panics(t, func() { mock.Get(2) })
+
+	withRuntime, assertWithRuntime := (&ShadowingTypeParamsWithRuntimeMocker[string, int, bool]{}).Describe().
+		Get().Takes(1).Returns("out", true).Times(1).
+		Mock()
+	defer assertWithRuntime(t)
+
+	out, ok = withRuntime.Get(1)
+	assert.Equal(t, "out", out)
+	assert.True(t, ok)
+}
+
+func TestShadowingInstallWithTypeParams(t *testing.T) {
+	var f ShadowingInstall[int, string]
+	ct := &cleanupT{}
+	(&ShadowingInstallMocker[int, string]{}).Describe().
+		Func().Takes(1).Returns("one").Times(1).
+		Install(&f, ct)
+
+	assert.Equal(t, "one", f(1))
+
+	ct.cleanup()
+	assert.Nil(t, f)
+	assert.Empty(t, ct.errs)
+}
@@ -0,0 +1,20 @@
+package examples_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/tcard/make.go.mock/examples"
+	"github.com/tcard/make.go.mock/examples/sharedmocks"
+)
+
+func TestMockInOtherDirectory(t *testing.T) {
+	mock, assertMock := (&sharedmocks.KeyValuesRepositoryMocker{}).Describe().
+		Put().Takes("foo").And(42).Returns(nil).Times(1).
+		Mock()
+	defer assertMock(t)
+
+	var repo examples.KeyValuesRepository = mock
+	assert.NoError(t, repo.Put("foo", 42))
+}
@@ -0,0 +1,34 @@
+package examples
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMockFromOtherPackage(t *testing.T) {
+	transport, assertMock := (&RoundTripperMocker{}).Describe().
+		RoundTrip().
+		TakesMatching(func(req *http.Request) error {
+			if req.URL.Path != "/foo" {
+				return errors.New("unexpected path " + req.URL.Path)
+			}
+			return nil
+		}).
+		Returns(&http.Response{
+			StatusCode: http.StatusTeapot,
+			Body:       io.NopCloser(strings.NewReader("")),
+		}, nil).
+		Times(1).
+		Mock()
+	defer assertMock(t)
+
+	client := &http.Client{Transport: transport}
+	resp, err := client.Get("http://example.com/foo")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusTeapot, resp.StatusCode)
+}
@@ -0,0 +1,7 @@
+package aliases
+
+// Count is an alias for a type that can't be mocked.
+type Count = int
+
+// Set is a generic alias.
+type Set[T comparable] = map[T]struct{}
@@ -0,0 +1,6 @@
+// Package broken has type errors, for testing that they are reported.
+package broken
+
+type Broken interface {
+	Get(key string) (Missing, error)
+}
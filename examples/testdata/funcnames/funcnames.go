@@ -0,0 +1,9 @@
+// Package funcnames has a function type with unnamed parameters, and an
+// unrelated function with the same signature.
+package funcnames
+
+type Validator func(string) error
+
+func DeletePath(path string) error {
+	return nil
+}
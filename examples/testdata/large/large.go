@@ -0,0 +1,109 @@
+// Package large has an interface with many methods, to benchmark the size of
+// its generated mock and the time it takes to compile.
+package large
+
+import "context"
+
+// Large has 100 methods.
+type Large interface {
+	Method000(key string) (string, error)
+	Method001(key string, value int) error
+	Method002(ctx context.Context, ids []int, opts ...string) ([]string, error)
+	Method003()
+	Method004(a, b int) int
+	Method005(key string) (string, error)
+	Method006(key string, value int) error
+	Method007(ctx context.Context, ids []int, opts ...string) ([]string, error)
+	Method008()
+	Method009(a, b int) int
+	Method010(key string) (string, error)
+	Method011(key string, value int) error
+	Method012(ctx context.Context, ids []int, opts ...string) ([]string, error)
+	Method013()
+	Method014(a, b int) int
+	Method015(key string) (string, error)
+	Method016(key string, value int) error
+	Method017(ctx context.Context, ids []int, opts ...string) ([]string, error)
+	Method018()
+	Method019(a, b int) int
+	Method020(key string) (string, error)
+	Method021(key string, value int) error
+	Method022(ctx context.Context, ids []int, opts ...string) ([]string, error)
+	Method023()
+	Method024(a, b int) int
+	Method025(key string) (string, error)
+	Method026(key string, value int) error
+	Method027(ctx context.Context, ids []int, opts ...string) ([]string, error)
+	Method028()
+	Method029(a, b int) int
+	Method030(key string) (string, error)
+	Method031(key string, value int) error
+	Method032(ctx context.Context, ids []int, opts ...string) ([]string, error)
+	Method033()
+	Method034(a, b int) int
+	Method035(key string) (string, error)
+	Method036(key string, value int) error
+	Method037(ctx context.Context, ids []int, opts ...string) ([]string, error)
+	Method038()
+	Method039(a, b int) int
+	Method040(key string) (string, error)
+	Method041(key string, value int) error
+	Method042(ctx context.Context, ids []int, opts ...string) ([]string, error)
+	Method043()
+	Method044(a, b int) int
+	Method045(key string) (string, error)
+	Method046(key string, value int) error
+	Method047(ctx context.Context, ids []int, opts ...string) ([]string, error)
+	Method048()
+	Method049(a, b int) int
+	Method050(key string) (string, error)
+	Method051(key string, value int) error
+	Method052(ctx context.Context, ids []int, opts ...string) ([]string, error)
+	Method053()
+	Method054(a, b int) int
+	Method055(key string) (string, error)
+	Method056(key string, value int) error
+	Method057(ctx context.Context, ids []int, opts ...string) ([]string, error)
+	Method058()
+	Method059(a, b int) int
+	Method060(key string) (string, error)
+	Method061(key string, value int) error
+	Method062(ctx context.Context, ids []int, opts ...string) ([]string, error)
+	Method063()
+	Method064(a, b int) int
+	Method065(key string) (string, error)
+	Method066(key string, value int) error
+	Method067(ctx context.Context, ids []int, opts ...string) ([]string, error)
+	Method068()
+	Method069(a, b int) int
+	Method070(key string) (string, error)
+	Method071(key string, value int) error
+	Method072(ctx context.Context, ids []int, opts ...string) ([]string, error)
+	Method073()
+	Method074(a, b int) int
+	Method075(key string) (string, error)
+	Method076(key string, value int) error
+	Method077(ctx context.Context, ids []int, opts ...string) ([]string, error)
+	Method078()
+	Method079(a, b int) int
+	Method080(key string) (string, error)
+	Method081(key string, value int) error
+	Method082(ctx context.Context, ids []int, opts ...string) ([]string, error)
+	Method083()
+	Method084(a, b int) int
+	Method085(key string) (string, error)
+	Method086(key string, value int) error
+	Method087(ctx context.Context, ids []int, opts ...string) ([]string, error)
+	Method088()
+	Method089(a, b int) int
+	Method090(key string) (string, error)
+	Method091(key string, value int) error
+	Method092(ctx context.Context, ids []int, opts ...string) ([]string, error)
+	Method093()
+	Method094(a, b int) int
+	Method095(key string) (string, error)
+	Method096(key string, value int) error
+	Method097(ctx context.Context, ids []int, opts ...string) ([]string, error)
+	Method098()
+	Method099(a, b int) int
+}
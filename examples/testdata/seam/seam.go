@@ -0,0 +1,3 @@
+// Package seam has type errors in a test file, for testing that they're
+// reported if they affect the types to mock.
+package seam
@@ -0,0 +1,5 @@
+package seam
+
+type Seam interface {
+	Get(key string) (Missing, error)
+}
@@ -0,0 +1,11 @@
+// Package typeparams has generic types with type parameters named like
+// identifiers in generated code, for testing that they're rejected.
+package typeparams
+
+type ShadowingDescriptor[d any] interface {
+	Get(x d) d
+}
+
+type ShadowingMocker[K comparable, m any] interface {
+	Get(key K) m
+}
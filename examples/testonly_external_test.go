@@ -0,0 +1,26 @@
+package examples_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+//go:generate make.go.mock -v -type Fetcher
+
+// Fetcher is declared in an external test package. Its mock goes into a test
+// file in the same external test package.
+type Fetcher func(url string) ([]byte, error)
+
+func TestMockDeclaredInExternalTestFile(t *testing.T) {
+	errNotFound := errors.New("not found")
+	mock, assertMock := (&FetcherMocker{}).Describe().
+		Func().Takes("https://example.com").Returns(nil, errNotFound).Times(1).
+		Mock()
+	defer assertMock(t)
+
+	fetch := Fetcher(mock)
+	_, err := fetch("https://example.com")
+	assert.Equal(t, errNotFound, err)
+}
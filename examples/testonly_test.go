@@ -0,0 +1,27 @@
+package examples
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+//go:generate make.go.mock -v -type Clock
+
+// Clock is declared in a test file, as test-only seams often are. Its mock
+// goes into a test file in the same package.
+type Clock interface {
+	Now() time.Time
+}
+
+func TestMockDeclaredInTestFile(t *testing.T) {
+	now := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	mock, assertMock := (&ClockMocker{}).Describe().
+		Now().Returns(now).Times(1).
+		Mock()
+	defer assertMock(t)
+
+	var clock Clock = mock
+	assert.Equal(t, now, clock.Now())
+}
@@ -1,8 +1,17 @@
 module github.com/tcard/make.go.mock
 
+go 1.25.0
+
 require (
-	github.com/google/go-cmp v0.3.0
+	github.com/google/go-cmp v0.6.0
+	github.com/pmezard/go-difflib v1.0.0
 	github.com/stretchr/testify v1.3.0
-	golang.org/x/tools v0.0.0-20190102213336-ca9055ed7d04
-	golang.org/x/xerrors v0.0.0-20190513163551-3ee3066db522
+	golang.org/x/mod v0.35.0
+	golang.org/x/tools v0.44.0
+	golang.org/x/xerrors v0.0.0-20191204190536-9bdfabe68543
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.0 // indirect
+	golang.org/x/sync v0.20.0 // indirect
 )
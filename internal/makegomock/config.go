@@ -0,0 +1,283 @@
+package makegomock
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"golang.org/x/tools/go/packages"
+	"golang.org/x/xerrors"
+)
+
+// Config describes a set of mocks to generate, typically for a whole module.
+//
+// It's read from a JSON file like:
+//
+//	{
+//		"mocks": [
+//			{"src": "./internal/store", "type": "Store", "dst": "./internal/store/mocks"},
+//			{"src": "./internal/clock", "type": "Clock,Timer=FakeTimer", "bare": true}
+//		]
+//	}
+type Config struct {
+	// Tags are comma-separated build tags to load all packages with.
+	Tags string `json:"tags"`
+	// Header is text to put in a comment at the top of each generated file,
+	// as with Options.Header.
+	Header string       `json:"header"`
+	Mocks  []ConfigMock `json:"mocks"`
+}
+
+// A ConfigMock describes the mocks for one or more types from the same
+// package, as with a single run of the command.
+type ConfigMock struct {
+	// Src is the directory, relative to the config file, or the import path
+	// of the package declaring the types. If empty, the config file's
+	// directory is used.
+	Src string `json:"src"`
+	// Type is a list of types as accepted by ParseTargets.
+	Type string `json:"type"`
+	// Func is a list of functions, as accepted by ParseTargets, to be mocked
+	// as with Target.Func.
+	Func string `json:"func"`
+	// All selects every interface and function type in the package, as with
+	// Target.All, filtered by the Include and Exclude regular expressions.
+	All     bool   `json:"all"`
+	Include string `json:"include"`
+	Exclude string `json:"exclude"`
+	// As is the base name for generated identifiers. It can only be used
+	// with a single type.
+	As string `json:"as"`
+	// Dst is the path of the generated file or directory, relative to the
+	// config file. If empty, the package's directory is used.
+	Dst string `json:"dst"`
+	// DstPkg is the package name for the generated files. If empty, it's
+	// inferred.
+	DstPkg string `json:"dstpkg"`
+	// Bare disables the declarative descriptors.
+	Bare bool `json:"bare"`
+	// Iface declares an interface for each concrete type, as with
+	// Target.Interface.
+	Iface bool `json:"iface"`
+	// Methods and ExcludeMethods are comma-separated lists of methods to mock
+	// and not to mock, as with Target.Methods and Target.ExcludeMethods.
+	Methods        string `json:"methods"`
+	ExcludeMethods string `json:"exclude-methods"`
+	// BuildTag is a build constraint expression to restrict the generated
+	// files to.
+	BuildTag string `json:"buildtag"`
+	// Assert adds compile-time assertions that the mocks match the original
+	// types.
+	Assert bool `json:"assert"`
+	// Runtime makes the mocks depend on package mockrt, as with
+	// Options.Runtime.
+	Runtime bool `json:"runtime"`
+}
+
+// label identifies m in error messages, by its types and functions.
+func (m ConfigMock) label() string {
+	var labels []string
+	if m.Type != "" {
+		labels = append(labels, m.Type)
+	}
+	if m.Func != "" {
+		labels = append(labels, "func "+m.Func)
+	}
+	if m.All {
+		labels = append(labels, "all")
+	}
+	return strings.Join(labels, "; ")
+}
+
+// ReadConfig reads a Config from a JSON file.
+func ReadConfig(path string) (*Config, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading config: %s", err)
+	}
+	var cfg Config
+	err = json.Unmarshal(b, &cfg)
+	if err != nil {
+		return nil, fmt.Errorf("parsing config at %s: %s", path, err)
+	}
+	return &cfg, nil
+}
+
+// FilesFromConfig generates all the mocks described by the config file at
+// configPath, returning the generated files without writing them.
+//
+// All packages are loaded at once, and then mocks are generated in parallel.
+// Failures don't stop the rest of mocks from being generated; they are all
+// reported at the end as an Errors, along with the files for the rest.
+func FilesFromConfig(configPath string) ([]File, error) {
+	cfg, err := ReadConfig(configPath)
+	if err != nil {
+		return nil, err
+	}
+	configDir, err := filepath.Abs(filepath.Dir(configPath))
+	if err != nil {
+		return nil, err
+	}
+
+	patterns := make([]string, 0, len(cfg.Mocks))
+	seen := map[string]struct{}{}
+	for _, m := range cfg.Mocks {
+		pattern := configPattern(m.Src)
+		if _, ok := seen[pattern]; ok {
+			continue
+		}
+		seen[pattern] = struct{}{}
+		patterns = append(patterns, pattern)
+	}
+
+	pkgs, err := packages.Load(&packages.Config{
+		Mode:       loadMode,
+		Dir:        configDir,
+		BuildFlags: buildFlags(cfg.Tags),
+	}, patterns...)
+	if err != nil {
+		return nil, fmt.Errorf("loading packages: %s", err)
+	}
+
+	results := make([][]File, len(cfg.Mocks))
+	errs := make([]error, len(cfg.Mocks))
+	var wg sync.WaitGroup
+	for i, m := range cfg.Mocks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i], errs[i] = generateConfigMock(m, cfg.Header, configDir, pkgs)
+			if w, ok := errs[i].(Warnings); ok {
+				for j, err := range w.Errs {
+					w.Errs[j] = fmt.Errorf("%s: mocks[%d] (%s): %s", configPath, i, m.label(), err)
+				}
+			} else if errs[i] != nil {
+				// Wrapped so that its diagnostics can be extracted.
+				errs[i] = xerrors.Errorf("%s: mocks[%d] (%s): %w", configPath, i, m.label(), errs[i])
+			}
+		}()
+	}
+	wg.Wait()
+
+	var files []File
+	var failed, warnings []error
+	for i, err := range errs {
+		if w, ok := err.(Warnings); ok {
+			warnings = append(warnings, w.Errs...)
+		} else if err != nil {
+			failed = append(failed, err)
+			continue
+		}
+		files = append(files, results[i]...)
+	}
+	if len(failed) > 0 {
+		return files, Errors{fmt.Errorf("%d of %d mocks in %s failed", len(failed), len(cfg.Mocks), configPath), append(failed, warnings...)}
+	}
+	if len(warnings) > 0 {
+		return files, Warnings{warnings}
+	}
+
+	return files, nil
+}
+
+func generateConfigMock(m ConfigMock, header, configDir string, pkgs []*packages.Package) ([]File, error) {
+	if m.Type == "" && m.Func == "" && !m.All {
+		return nil, fmt.Errorf("expected non-empty type or func, or all")
+	}
+	var targets []Target
+	if m.Type != "" {
+		var err error
+		targets, err = ParseTargets(m.Type)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if m.Func != "" {
+		funcs, err := ParseTargets(m.Func)
+		if err != nil {
+			return nil, err
+		}
+		for i := range funcs {
+			funcs[i].Func = true
+		}
+		targets = append(targets, funcs...)
+	}
+	if m.All {
+		target, err := AllTarget(m.Include, m.Exclude)
+		if err != nil {
+			return nil, err
+		}
+		targets = append(targets, target)
+	}
+	if m.As != "" {
+		if len(targets) > 1 || m.All {
+			return nil, fmt.Errorf("as can't be used with several types; use type Name=Alias instead")
+		}
+		targets[0].Rename = m.As
+	}
+	if (m.Methods != "" || m.ExcludeMethods != "") && m.Type == "" {
+		return nil, fmt.Errorf("methods and exclude-methods can only be used with type")
+	}
+	for i := range targets {
+		if targets[i].All || targets[i].Func {
+			continue
+		}
+		targets[i].Interface = m.Iface
+		targets[i].Methods = ParseMethods(m.Methods)
+		targets[i].ExcludeMethods = ParseMethods(m.ExcludeMethods)
+	}
+
+	pattern := configPattern(m.Src)
+	var pkg *packages.Package
+	for _, p := range pkgs {
+		if matchesPattern(p, pattern, configDir) {
+			pkg = p
+			break
+		}
+	}
+	if pkg == nil {
+		return nil, fmt.Errorf("didn't load package %s", pattern)
+	}
+
+	return generateFilesInPackage(m.Dst, m.DstPkg, configDir, pkg, targets, Options{
+		Bare:     m.Bare,
+		BuildTag: m.BuildTag,
+		Header:   header,
+		Assert:   m.Assert,
+		Runtime:  m.Runtime,
+	})
+}
+
+func configPattern(src string) string {
+	if src == "" {
+		return "."
+	}
+	return src
+}
+
+// matchesPattern tells whether pkg is the package that pattern, a directory
+// relative to dir or an import path, refers to.
+func matchesPattern(pkg *packages.Package, pattern, dir string) bool {
+	if pkg.ID == pattern {
+		// Packages that failed to load are identified by the pattern.
+		return true
+	}
+	if !isDirPattern(pattern) {
+		return pkg.PkgPath == pattern
+	}
+	if len(pkg.GoFiles) == 0 {
+		return false
+	}
+	if !filepath.IsAbs(pattern) {
+		pattern = filepath.Join(dir, pattern)
+	}
+	return filepath.Clean(pattern) == filepath.Dir(pkg.GoFiles[0])
+}
+
+func isDirPattern(pattern string) bool {
+	return filepath.IsAbs(pattern) || pattern == "." || pattern == ".." ||
+		strings.HasPrefix(pattern, "./") || strings.HasPrefix(pattern, "../")
+}
@@ -17,8 +17,8 @@ func (g *generator) generateDescribeMethod() error {
 //
 // When you're done describing methods, call Mock to get a mock that implements
 // the behavior you described.
-func (m *`+mockerName+`) Describe() `+descriptorName+` {
-	return `+descriptorName+`{m: m}
+func (m *`+mockerName+g.typeArgs+`) Describe() `+descriptorName+g.typeArgs+` {
+	return `+descriptorName+g.typeArgs+`{m: m}
 }
 
 // A `+descriptorName+` lets you describe how the methods on the resulting mock are expected
@@ -26,8 +26,8 @@ func (m *`+mockerName+`) Describe() `+descriptorName+` {
 //
 // When you're done describing methods, call its Mock method to get a mock that
 // implements the behavior you described.
-type `+descriptorName+` struct {
-	m *`+mockerName)
+type `+descriptorName+g.typeParams+` struct {
+	m *`+mockerName+g.typeArgs)
 	if err != nil {
 		return err
 	}
@@ -35,7 +35,7 @@ type `+descriptorName+` struct {
 	for _, method := range g.methods {
 		methodDescName := g.rename + method.name + "MockDescriptor"
 		_, err := io.WriteString(g.w, `
-	descriptors_`+method.name+` []*`+methodDescName)
+	descriptors_`+method.name+` []*`+methodDescName+g.typeArgs)
 		if err != nil {
 			return err
 		}
@@ -50,12 +50,52 @@ type `+descriptorName+` struct {
 // It also returns a function that should be called before the test is done to
 // ensure that the expected number of calls to the mock methods happened. You
 // can pass a *testing.T to it, since it implements the interface it wants.
-func (d `+descriptorName+`) Mock() (m `+g.rename+`Mock, assert func(t interface{ Errorf(s string, args ...interface{})  }) (ok bool)) {
+func (d `+descriptorName+g.typeArgs+`) Mock() (m `+g.rename+`Mock`+g.typeArgs+`, assert func(t interface{ Errorf(s string, args ...interface{}) }) (ok bool)) {
 	assert = d.done()
 	return d.m.Mock(), assert
 }
+`)
+	if err != nil {
+		return err
+	}
+
+	if g.runtime {
+		err = g.generateRuntimeDone()
+	} else {
+		err = g.generateDone()
+	}
+	if err != nil {
+		return err
+	}
+
+	if g.installType != "" {
+		err = g.generateInstall()
+		if err != nil {
+			return err
+		}
+	}
+
+	err = g.generateDescribedCall()
+	if err != nil {
+		return err
+	}
+
+	for _, method := range g.methods {
+		err := g.generateMethodDescriptor(method)
+		if err != nil {
+			return xerrors.Errorf("generating descriptor for %s: %w", g.fullName(method), err)
+		}
+	}
+
+	return nil
+}
 
-func (d `+descriptorName+`) done() func(t interface{ Errorf(s string, args ...interface{})  }) bool {
+// generateDone generates the method that, once all calls are described, sets
+// up the mocker's fields to match and count them.
+func (g *generator) generateDone() error {
+	descriptorName := g.rename + "MockDescriptor"
+	_, err := io.WriteString(g.w, `
+func (d `+descriptorName+g.typeArgs+`) done() func(t interface{ Errorf(s string, args ...interface{}) }) bool {
 	var atAssert []func() (method string, errs []string)
 	type specErrs struct {
 		fileLine string
@@ -104,7 +144,7 @@ func (d `+descriptorName+`) done() func(t interface{ Errorf(s string, args ...in
 			})
 		}
 		d.m.`+method.name+` = func`+methodSigSpread+` {
-			var matching []*`+methodDescName+`
+			var matching []*`+methodDescName+g.typeArgs+`
 			var allErrs []specErrs
 			for _, desc := range d.descriptors_`+method.name+` {
 				errs := desc.argValidator(`+callArgs+`)
@@ -151,7 +191,7 @@ func (d `+descriptorName+`) done() func(t interface{ Errorf(s string, args ...in
 	}
 
 	_, err = io.WriteString(g.w, `
-	return func(t interface{ Errorf(s string, args ...interface{})  }) bool {
+	return func(t interface{ Errorf(s string, args ...interface{}) }) bool {
 		ok := true
 		for _, assert := range atAssert {
 			method, errs := assert()
@@ -164,55 +204,249 @@ func (d `+descriptorName+`) done() func(t interface{ Errorf(s string, args ...in
 	}
 }
 	`)
+	return err
+}
+
+// generateRuntimeDone is like generateDone, but delegates matching and
+// counting calls to package mockrt.
+func (g *generator) generateRuntimeDone() error {
+	descriptorName := g.rename + "MockDescriptor"
+	_, err := io.WriteString(g.w, `
+func (d `+descriptorName+g.typeArgs+`) done() func(t interface{ Errorf(s string, args ...interface{}) }) bool {
+	m := `+g.mockrtPkg+`.NewMock("`+g.rename+`")`)
 	if err != nil {
 		return err
 	}
 
-	closingMethods := [][2]string{}
 	for _, method := range g.methods {
-		methodDescName := g.rename + method.name + "MockDescriptor"
-		closingMethods = append(closingMethods, [2]string{method.name, `*` + methodDescName})
-	}
+		methodSigSpread := sigStr(method.sig, true)
+		callArgs := argsForCall(method.sig.args, method.sig.variadic, false)
 
-	for _, method := range g.methods {
-		err := g.generateMethodDescriptor(method, closingMethods)
+		call := `
+			args.Match()`
+		if len(method.sig.ret) > 0 {
+			call = `
+			return d.descriptors_` + method.name + `[args.Match()].call(` + callArgs + `)`
+		}
+
+		_, err = io.WriteString(g.w, `
+	{
+		calls := m.Method("`+method.name+`")
+		for _, desc := range d.descriptors_`+method.name+` {
+			calls.Expect(desc.fileLine, desc.times)
+		}
+		d.m.`+method.name+` = func`+methodSigSpread+` {
+			args := calls.Call(`+callArgs+`)
+			for _, desc := range d.descriptors_`+method.name+` {
+				args.Check(desc.argValidator(`+callArgs+`))
+			}`+call+`
+		}
+	}`)
 		if err != nil {
-			return xerrors.Errorf("generating descriptor for %s: %w", g.fullName(method), err)
+			return err
 		}
 	}
 
-	return nil
+	_, err = io.WriteString(g.w, `
+	return m.Assert
+}
+`)
+	return err
+}
+
+// localName returns name, suffixed with underscores if needed so that it
+// doesn't shadow the type parameters of the mocked type, for declarations
+// that typeParamReservedNames doesn't cover.
+func (g *generator) localName(name string) string {
+	tparams := typeParamNames(g.typ)
+	for {
+		taken := false
+		for _, tparam := range tparams {
+			taken = taken || tparam == name
+		}
+		if !taken {
+			return name
+		}
+		name += "_"
+	}
+}
+
+// generateInstall generates the method that installs the mock for a function
+// type into a variable for the duration of a test.
+func (g *generator) generateInstall() error {
+	descriptorName := g.rename + "MockDescriptor"
+	installedName := "_makegomock_" + g.rename + "Installed"
+	target, t := g.localName("target"), g.localName("t")
+	loaded, m, assert, prev := g.localName("loaded"), g.localName("m"), g.localName("assert"), g.localName("prev")
+	mock := g.installType + "(" + m + ")"
+	if g.fn != nil || g.field.isLiteral() {
+		// The mock is assignable to the unnamed function type.
+		mock = m
+	}
+	_, err := io.WriteString(g.w, `
+// Install sets *`+target+` to the mock, as Mock returns it, until the test that `+t+`
+// belongs to finishes. Then, it restores the previous value of *`+target+`, and
+// asserts that the mock was called as expected. You can pass a *testing.T to
+// it.
+//
+// It's meant for package-level variables that the code under test calls, like
+// var now = time.Now, which would otherwise need to be saved and restored by
+// hand.
+//
+// It panics if a `+g.rename+` mock is already installed into `+target+`, as it
+// would happen if tests installing it ran in parallel.
+func (d `+descriptorName+g.typeArgs+`) Install(`+target+` *`+g.installType+`, `+t+` interface {
+	Cleanup(func())
+	Errorf(s string, args ...interface{})
+}) {
+	if _, `+loaded+` := `+installedName+`.LoadOrStore(`+target+`, struct{}{}); `+loaded+` {
+		panic("a mock for `+g.name+` is already installed into the target variable; tests installing it can't run in parallel")
+	}
+	`+m+`, `+assert+` := d.Mock()
+	`+prev+` := *`+target+`
+	*`+target+` = `+mock+`
+	`+t+`.Cleanup(func() {
+		*`+target+` = `+prev+`
+		`+installedName+`.Delete(`+target+`)
+		`+assert+`(`+t+`)
+	})
+}
+
+// `+installedName+` holds the variables that a `+g.rename+` mock is installed into.
+var `+installedName+` `+g.syncPkg+`.Map
+`)
+	return err
+}
+
+// generateDescribedCall generates the type that ends the description of a
+// call to any of the methods.
+//
+// It embeds the mock descriptor, so that the description of another call can
+// be started from it without a method for each of them, which would make the
+// generated code grow quadratically with the number of methods.
+func (g *generator) generateDescribedCall() error {
+	descriptorName := g.rename + "MockDescriptor"
+	descriptorType := descriptorName + g.typeArgs
+	describedCallName := g.rename + "MockDescribedCall"
+
+	timesBody := `	return d.TimesMatching(func(got int) error {
+		if got != times {
+			return ` + g.fmtPkg + `.Errorf("expected exactly %d calls, got %d", times, got)
+		}
+		return nil
+	})`
+	atLeastTimesBody := `	return d.TimesMatching(func(got int) error {
+		if got < times {
+			return ` + g.fmtPkg + `.Errorf("expected at least %d calls, got %d", times, got)
+		}
+		return nil
+	})`
+	if g.runtime {
+		timesBody = `	return d.TimesMatching(` + g.mockrtPkg + `.Times(times))`
+		atLeastTimesBody = `	return d.TimesMatching(` + g.mockrtPkg + `.AtLeastTimes(times))`
+	}
+
+	_, err := io.WriteString(g.w, `
+// `+describedCallName+` is the last step in the description of a way that a
+// method of `+g.rename+` is to behave when called, with all expected parameters
+// and the resulting values specified.
+//
+// It has methods to describe the times the method is expected to be called.
+// Otherwise, through the embedded `+descriptorName+`, you can start
+// another method call description, or you can call Mock to end the description
+// and get the resulting mock.
+type `+describedCallName+g.typeParams+` struct {
+	`+descriptorType+`
+	times *func(int) error
+}
+
+// Times lets you specify a exact number of times this method is expected to be
+// called.
+func (d `+describedCallName+g.typeArgs+`) Times(times int) `+descriptorType+` {
+`+timesBody+`
+}
+
+// AtLeastTimes lets you specify a minimum number of times this method is expected to be
+// called.
+func (d `+describedCallName+g.typeArgs+`) AtLeastTimes(times int) `+descriptorType+` {
+`+atLeastTimesBody+`
 }
 
-func (g *generator) generateMethodDescriptor(method method, closingMethods [][2]string) error {
+// TimesMatching lets you pass a function to accept or reject the number of times
+// this method has been called.
+func (d `+describedCallName+g.typeArgs+`) TimesMatching(f func(times int) error) `+descriptorType+` {
+	*d.times = f
+	return d.`+descriptorName+`
+}
+`)
+	return err
+}
+
+func (g *generator) generateMethodDescriptor(method method) error {
 	descriptorName := g.rename + "MockDescriptor"
+	descriptorType := descriptorName + g.typeArgs
+	describedCallType := g.rename + "MockDescribedCall" + g.typeArgs
 	methodDescName := g.rename + method.name + "MockDescriptor"
+	methodDescType := methodDescName + g.typeArgs
 	argValidatorSig := validatorSig(method.sig)
 	argValidatorSigStr := "func" + sigStr(argValidatorSig, false)
+	maybeDoc := ""
+	if method.doc != "" {
+		maybeDoc = `
+//
+// ` + g.name + `.` + method.name + ` is documented as follows.
+//` + docComment("", method.doc)
+	}
+
+	args := make([]argument, 0, len(method.sig.args)+1)
+	for _, arg := range method.sig.args {
+		args = append(args, arg)
+	}
+	if method.sig.variadic != nil {
+		arg := *method.sig.variadic
+		arg.typ = "[]" + arg.typ
+		args = append(args, arg)
+	}
+
+	caller := `	_, file, line, _ := ` + g.runtimePkg + `.Caller(2)`
+	fileLine := g.fmtPkg + `.Sprintf("%s:%d", file, line)`
+	if g.runtime {
+		caller = ""
+		fileLine = g.mockrtPkg + `.Caller(2)`
+	}
+
+	// Without parameters or results, there's nothing to describe but the
+	// times it's called.
+	startReturns := "*" + methodDescType
+	start := "d.new" + methodDescName + "()"
+	if len(args) == 0 && len(method.sig.ret) == 0 {
+		startReturns = describedCallType
+		start += ".done()"
+	}
 
 	_, err := io.WriteString(g.w, `
 // `+method.name+` starts describing a way method `+g.rename+`.`+method.name+` is expected to be called
 // and what it should return.
 //
-// You can call it several times to describe different behaviors, each matching different parameters.
-func (d `+descriptorName+`) `+method.name+`() *`+methodDescName+` {
-	return d.new`+methodDescName+`()
+// You can call it several times to describe different behaviors, each matching different parameters.`+maybeDoc+`
+func (d `+descriptorType+`) `+method.name+`() `+startReturns+` {
+	return `+start+`
 }
 
-func (d `+descriptorName+`) new`+methodDescName+`() *`+methodDescName+` {
-	_, file, line, _ := `+g.runtimePkg+`.Caller(2)
-	return &`+methodDescName+`{
+func (d `+descriptorType+`) new`+methodDescName+`() *`+methodDescType+` {
+`+caller+`
+	return &`+methodDescType+`{
 		mockDesc: d,
 		times: func(int) error { return nil },
 		argValidator: `+argValidatorSigStr+` { return nil },
-		fileLine: `+g.fmtPkg+`.Sprintf("%s:%d", file, line),
+		fileLine: `+fileLine+`,
 	}
 }
 
 // `+methodDescName+` is returned by `+descriptorName+`.`+method.name+` and
 // holds methods to describe the mock for method `+g.rename+`.`+method.name+`.
-type `+methodDescName+` struct {
-	mockDesc `+descriptorName+`
+type `+methodDescName+g.typeParams+` struct {
+	mockDesc `+descriptorType+`
 	times func(int) error
 	argValidator `+argValidatorSigStr+`
 	call func`+sigStr(method.sig, false)+`
@@ -223,19 +457,9 @@ type `+methodDescName+` struct {
 		return err
 	}
 
-	receiver := "*" + methodDescName
+	receiver := "*" + methodDescType
 	methodDesc := "d"
 
-	args := make([]argument, 0, len(method.sig.args)+1)
-	for _, arg := range method.sig.args {
-		args = append(args, arg)
-	}
-	if method.sig.variadic != nil {
-		arg := *method.sig.variadic
-		arg.typ = "[]" + arg.typ
-		args = append(args, arg)
-	}
-
 	for i, arg := range args {
 		prefix := "And"
 		suffix := "Args"
@@ -243,15 +467,19 @@ type `+methodDescName+` struct {
 			prefix = "Takes"
 			suffix = "Arg"
 		}
-		descriptorReturns := fmt.Sprintf("%sWith%d%s", methodDescName, i+1, suffix)
+		descriptorReturnsName := fmt.Sprintf("%sWith%d%s", methodDescName, i+1, suffix)
+		descriptorReturns := descriptorReturnsName + g.typeArgs
+		last := i == len(args)-1 && len(method.sig.ret) == 0
+		next := descriptorReturns + `{` + methodDesc + `}`
+		if last {
+			descriptorReturns = describedCallType
+			next = methodDesc + `.done()`
+		}
 
 		optsArg := argument{
 			name: "opts",
 			typ:  g.cmpPkg + ".Option",
 		}
-		if arg.name == optsArg.name {
-			optsArg.name += "_"
-		}
 
 		// We need to undo this randomness for the examples:
 		//
@@ -259,7 +487,7 @@ type `+methodDescName+` struct {
 		_, err := io.WriteString(g.w, `
 // `+prefix+` lets you specify a value with which the actual value passed to
 // the mocked method `+g.rename+`.`+method.name+` as parameter #`+fmt.Sprintf("%d", i+1)+`
-// will be compared. 
+// will be compared.
 //
 // Package "github.com/google/go-cmp/cmp" is used to do the comparison. You can
 // pass extra options for it.
@@ -271,18 +499,18 @@ func (d `+receiver+`) `+prefix+`(`+argsStr([]argument{arg}, &optsArg, true)+`) `
 	prev := `+methodDesc+`.argValidator
 	`+methodDesc+`.argValidator = `+argValidatorSigStr+` {
 		errMsgs := prev(`+argsForCall(argValidatorSig.args, argValidatorSig.variadic, false)+`)
-		if diff := `+g.cmpPkg+`.Diff(`+arg.name+`, got_`+arg.name+`, `+optsArg.name+`...); diff != "" {
+		if diff := `+g.cmpPkg+`.Diff(`+arg.name+`, `+validatorArgPrefix+arg.name+`, `+optsArg.name+`...); diff != "" {
 			errMsgs = append(errMsgs, "parameter #`+fmt.Sprintf("%d", i+1)+` mismatch:\n" + diff)
 		}
 		return errMsgs
 	}
-	return `+descriptorReturns+`{`+methodDesc+`}
+	return `+next+`
 }
 
 // `+prefix+`Any declares that any value passed to the mocked method
 // `+method.name+` as parameter #`+fmt.Sprintf("%d", i+1)+` is expected.
 func (d `+receiver+`) `+prefix+`Any() `+descriptorReturns+` {
-	return `+descriptorReturns+`{`+methodDesc+`}
+	return `+next+`
 }
 
 // `+prefix+`Matching lets you pass a function to accept or reject the actual
@@ -291,23 +519,30 @@ func (d `+receiver+`) `+prefix+`Matching(match func(`+argStr(arg, false, false)+
 	prev := `+methodDesc+`.argValidator
 	`+methodDesc+`.argValidator = `+argValidatorSigStr+` {
 		errMsgs := prev(`+argsForCall(argValidatorSig.args, argValidatorSig.variadic, false)+`)
-		if err := match(got_`+arg.name+`); err != nil {
+		if err := match(`+validatorArgPrefix+arg.name+`); err != nil {
 			errMsgs = append(errMsgs, "parameter \"`+arg.name+`\" custom matcher error: " + err.Error())
 		}
 		return errMsgs
 	}
-	return `+descriptorReturns+`{`+methodDesc+`}
+	return `+next+`
 }
+`)
+		if err != nil {
+			return err
+		}
+		if last {
+			break
+		}
 
-// `+descriptorReturns+` is a step forward in the description of a way that the
+		_, err = io.WriteString(g.w, `
+// `+descriptorReturnsName+` is a step forward in the description of a way that the
 // method `+g.rename+`.`+method.name+` is expected to be called, with `+fmt.Sprintf("%d", i+1)+`
 // arguments specified.
 //
-// It has methods to describe the next argument, if there's
-// any left, or the return values, if there are any, or the times it's expected
-// to be called otherwise.
-type `+descriptorReturns+` struct {
-	methodDesc *`+methodDescName+`
+// It has methods to describe the next argument, if there's any left, or the
+// return values otherwise.
+type `+descriptorReturnsName+g.typeParams+` struct {
+	methodDesc *`+methodDescType+`
 }
 	`)
 		if err != nil {
@@ -319,116 +554,37 @@ type `+descriptorReturns+` struct {
 	}
 
 	if len(method.sig.ret) > 0 {
-		descriptorReturns := methodDescName + "WithReturn"
-
 		_, err := io.WriteString(g.w, `
 // Returns lets you specify the values that the mocked method `+g.rename+`.`+method.name+`,
 // if called with values matching the expectations, will return.
-func (d `+receiver+`) Returns(`+argsStr(method.sig.ret, nil, false)+`) `+descriptorReturns+` {
+func (d `+receiver+`) Returns(`+argsStr(method.sig.ret, nil, false)+`) `+describedCallType+` {
 	return d.ReturnsFrom(func`+sigStrNoNames(method.sig, false)+` {
 		return `+argsForCall(method.sig.ret, nil, false)+`
 	})
 }
 
-// Returns lets you specify the values that the mocked method `+g.rename+`.`+method.name+`,
+// ReturnsFrom lets you specify the values that the mocked method `+g.rename+`.`+method.name+`,
 // if called with values matching the expectations, will return.
-// 
-// It passes such passed values to a function that then returns the return values. 
-func (d `+receiver+`) ReturnsFrom(f func`+sigStr(method.sig, false)+`) `+descriptorReturns+` {
-	`+methodDesc+`.call = f
-	return `+descriptorReturns+`{`+methodDesc+`}
-}
-
-// `+descriptorReturns+` is a step forward in the description of a way that
-// method `+g.rename+`.`+method.name+` is to behave when called, with all expected parameters
-// and the resulting values specified.
-// arguments specified.
-// 
-// It has methods to describe the times the method is expected to be called,
-// or you can start another method call description, or you can call Mock to
-// end the description and get the resulting mock.
-type `+descriptorReturns+` struct {
-	methodDesc *`+methodDescName+`
-}
-	`)
-		if err != nil {
-			return err
-		}
-
-		receiver = descriptorReturns
-		methodDesc = "d.methodDesc"
-	}
-
-	_, err = io.WriteString(g.w, `
-// Times lets you specify a exact number of times this method is expected to be
-// called.
-func (d `+receiver+`) Times(times int) `+descriptorName+` {
-	return d.TimesMatching(func(got int) error {
-		if got != times {
-			return fmt.Errorf("expected exactly %d calls, got %d", times, got)
-		}
-		return nil
-	})
-}
-
-// AtLeastTimes lets you specify a minimum number of times this method is expected to be
-// called.
-func (d `+receiver+`) AtLeastTimes(times int) `+descriptorName+` {
-	return d.TimesMatching(func(got int) error {
-		if got < times {
-			return fmt.Errorf("expected at least %d calls, got %d", times, got)
-		}
-		return nil
-	})
-}
-
-// TimesMatching lets you pass a function to accept or reject the number of times
-// this method has been called.
-func (d `+receiver+`) TimesMatching(f func(times int) error) `+descriptorName+` {
-	`+methodDesc+`.times = f
-	`+methodDesc+`.done()
-	return `+methodDesc+`.mockDesc
-}
-
-// Mock finishes the description and produces a mock.
 //
-// See `+g.rename+`MockDescriptor.Mock for details.
-func (d `+receiver+`) Mock() (m `+g.rename+`Mock, assert func(t interface{ Errorf(string, ...interface{})  }) (ok bool)) {
-	`+methodDesc+`.done()
-	return `+methodDesc+`.mockDesc.Mock()
-}
-	`)
-	if err != nil {
-		return err
-	}
-
-	for _, m := range closingMethods {
-		methodDescName := g.rename + m[0] + "MockDescriptor"
-		_, err = io.WriteString(g.w, `
-// `+m[0]+` finishes the current description for method `+g.rename+`.`+method.name+` and
-// starts describing for method `+m[0]+`.
-//
-// See `+g.rename+`MockDescriptor.`+m[0]+` for details.
-func (d `+receiver+`) `+m[0]+`() `+m[1]+` {
-	`+methodDesc+`.done()
-	return `+methodDesc+`.mockDesc.new`+methodDescName+`()
+// It passes such passed values to a function that then returns the return values.
+func (d `+receiver+`) ReturnsFrom(f func`+sigStr(method.sig, false)+`) `+describedCallType+` {
+	`+methodDesc+`.call = f
+	return `+methodDesc+`.done()
 }
-	`)
+`)
 		if err != nil {
 			return err
 		}
 	}
 
 	_, err = io.WriteString(g.w, `
-func (d *`+methodDescName+`) done() {
+// done ends the description, adding it to the mock descriptor.
+func (d *`+methodDescType+`) done() `+describedCallType+` {
 	d.mockDesc.descriptors_`+method.name+` = append(d.mockDesc.descriptors_`+method.name+`, d)
+	return `+describedCallType+`{d.mockDesc, &d.times}
 }
-	`)
-	if err != nil {
-		return err
-	}
-
-	return nil
+`)
+	return err
 }
 
 func (g *generator) fullName(method method) string {
@@ -458,13 +614,13 @@ func validatorSig(sig signature) signature {
 	sig.ret = []argument{{typ: "[]string"}}
 	args := make([]argument, 0, len(sig.args))
 	for _, arg := range sig.args {
-		arg.name = "got_" + arg.name
+		arg.name = validatorArgPrefix + arg.name
 		args = append(args, arg)
 	}
 	sig.args = args
 	if sig.variadic != nil {
 		arg := *sig.variadic
-		arg.name = "got_" + arg.name
+		arg.name = validatorArgPrefix + arg.name
 		sig.variadic = &arg
 	}
 	return sig
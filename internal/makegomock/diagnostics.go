@@ -0,0 +1,179 @@
+package makegomock
+
+import (
+	"go/ast"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+	"golang.org/x/xerrors"
+)
+
+// A Diagnostic is an error, like those found while loading packages, with its
+// position in a source file if known.
+type Diagnostic struct {
+	// File, Line and Column are the position of the error. Those unknown are
+	// empty or zero.
+	File   string `json:"file,omitempty"`
+	Line   int    `json:"line,omitempty"`
+	Column int    `json:"column,omitempty"`
+	// Kind is "list", "parse", "type" or "unknown" for errors loading
+	// packages, as reported by the go command and the type checker; "warning"
+	// for Warnings; and "error" for anything else.
+	Kind    string `json:"kind"`
+	Message string `json:"message"`
+}
+
+// Error implements error. Positioned diagnostics are formatted as the compiler
+// does, like file.go:12:3: message.
+func (d Diagnostic) Error() string {
+	if d.File == "" {
+		return d.Message
+	}
+	pos := d.File
+	if d.Line > 0 {
+		pos += ":" + strconv.Itoa(d.Line)
+		if d.Column > 0 {
+			pos += ":" + strconv.Itoa(d.Column)
+		}
+	}
+	return pos + ": " + d.Message
+}
+
+// Diagnostics flattens err into the diagnostics it's made of. Errors and
+// Warnings, even if wrapped, are replaced by the errors they hold, and errors
+// that aren't a Diagnostic are turned into one without a position.
+func Diagnostics(err error) []Diagnostic {
+	if err == nil {
+		return nil
+	}
+
+	var d Diagnostic
+	var errs Errors
+	var warnings Warnings
+	switch {
+	case xerrors.As(err, &d):
+		return []Diagnostic{d}
+	case xerrors.As(err, &errs) && len(errs.Errs) > 0:
+		var ds []Diagnostic
+		for _, err := range errs.Errs {
+			ds = append(ds, Diagnostics(err)...)
+		}
+		return ds
+	case xerrors.As(err, &warnings):
+		var ds []Diagnostic
+		for _, err := range warnings.Errs {
+			for _, d := range Diagnostics(err) {
+				if d.Kind == "error" {
+					d.Kind = "warning"
+				}
+				ds = append(ds, d)
+			}
+		}
+		return ds
+	}
+	return []Diagnostic{{Kind: "error", Message: err.Error()}}
+}
+
+// packageErrors returns pkg's errors as diagnostics, or nil if there are none.
+//
+// Type errors in files generated by make.go.mock are left out, since they're
+// likely to be mocks that are stale, and which regenerating would fix. So are
+// those in test files, which are likely to use mocks not generated yet. If
+// they make the types to mock invalid, skippedPackageErrors reports them.
+func packageErrors(pkg *packages.Package) []error {
+	errs, _ := splitPackageErrors(pkg)
+	return errs
+}
+
+// skippedPackageErrors returns the type errors that packageErrors leaves out,
+// as diagnostics.
+func skippedPackageErrors(pkg *packages.Package) []error {
+	_, skipped := splitPackageErrors(pkg)
+	return skipped
+}
+
+func splitPackageErrors(pkg *packages.Package) (errs, skipped []error) {
+	generated := map[string]bool{}
+	for _, f := range pkg.Syntax {
+		if hasGeneratedHeader(f) {
+			generated[pkg.Fset.File(f.Pos()).Name()] = true
+		}
+	}
+	typeErrors := false
+	for _, err := range pkg.Errors {
+		typeErrors = typeErrors || err.Kind == packages.TypeError
+	}
+
+	for _, err := range pkg.Errors {
+		d := newDiagnostic(err)
+		if err.Kind == packages.TypeError && (generated[d.File] || isTestFile(d.File)) {
+			skipped = append(skipped, d)
+			continue
+		}
+		if err.Kind == packages.ListError && d.File == "" && strings.HasPrefix(d.Message, "# ") && typeErrors {
+			// The compiler's output, as reported by the go command. The
+			// type checker already reported the same errors, positioned.
+			continue
+		}
+		errs = append(errs, d)
+	}
+	return errs, skipped
+}
+
+// hasGeneratedHeader tells whether f was generated by make.go.mock.
+func hasGeneratedHeader(f *ast.File) bool {
+	for _, group := range f.Comments {
+		if group.Pos() > f.Package {
+			break
+		}
+		for _, c := range group.List {
+			if c.Text == generatedHeader {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func newDiagnostic(err packages.Error) Diagnostic {
+	d := Diagnostic{Message: err.Msg}
+	switch err.Kind {
+	case packages.ListError:
+		d.Kind = "list"
+	case packages.ParseError:
+		d.Kind = "parse"
+	case packages.TypeError:
+		d.Kind = "type"
+	default:
+		d.Kind = "unknown"
+	}
+
+	// Pos is file:line:col, file:line, file, or empty or - if unknown. The
+	// file name may have colons itself, so numbers are taken from the end.
+	pos := err.Pos
+	if pos == "-" {
+		pos = ""
+	}
+	var nums []int
+	for len(nums) < 2 {
+		i := strings.LastIndexByte(pos, ':')
+		if i < 0 {
+			break
+		}
+		n, convErr := strconv.Atoi(pos[i+1:])
+		if convErr != nil {
+			break
+		}
+		nums = append([]int{n}, nums...)
+		pos = pos[:i]
+	}
+	d.File = pos
+	if len(nums) > 0 {
+		d.Line = nums[0]
+	}
+	if len(nums) > 1 {
+		d.Column = nums[1]
+	}
+	return d
+}
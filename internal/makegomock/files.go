@@ -0,0 +1,143 @@
+package makegomock
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+// StdoutPath is the destination path that makes generated code be written to
+// standard output. All types are then generated into a single file.
+const StdoutPath = "-"
+
+// A File is a generated Go source file, not yet written.
+type File struct {
+	// Path is where the file is to be written.
+	Path string
+	// PkgName and ImportPath are the package name and import path of the
+	// package the file belongs to.
+	PkgName    string
+	ImportPath string
+	// Code is the generated Go source code.
+	Code []byte
+	// Imports are the import paths of the packages the code imports.
+	Imports []string
+	// Mocks describe the mocks in the file, in the order they're generated.
+	Mocks []Mock
+}
+
+// A Mock describes the code generated for a mocked type.
+type Mock struct {
+	// Type is the name of the mocked type, with type arguments if it's an
+	// instantiation, and TypePkg is the import path of its package.
+	Type    string
+	TypePkg string
+	// Name is the base name of the generated identifiers, like Name+"Mocker".
+	Name string
+	// Methods are the names of the mocked methods, which are also the names of
+	// the Mocker's fields. For function types, it's just Func.
+	Methods []string
+}
+
+// Write writes the file's code to its path, creating its directory if needed.
+func (f File) Write() error {
+	if f.Path == StdoutPath {
+		_, err := os.Stdout.Write(f.Code)
+		if err != nil {
+			return fmt.Errorf("writing to standard output: %s", err)
+		}
+		return nil
+	}
+
+	dstDir := filepath.Dir(f.Path)
+	err := os.MkdirAll(dstDir, 0755)
+	if err != nil {
+		return fmt.Errorf("creating destination directory at %s: %s", dstDir, err)
+	}
+
+	err = os.WriteFile(f.Path, f.Code, 0644)
+	if err != nil {
+		return fmt.Errorf("writing into %s: %s", f.Path, err)
+	}
+
+	return nil
+}
+
+// Check compares the file's code with the one currently at its path, without
+// writing anything. If they differ, or there's no file, it returns a
+// StaleError.
+func (f File) Check() error {
+	if f.Path == StdoutPath {
+		return fmt.Errorf("can't check code written to standard output")
+	}
+	existing, err := os.ReadFile(f.Path)
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("reading %s: %s", f.Path, err)
+	}
+	if err == nil && bytes.Equal(existing, f.Code) {
+		return nil
+	}
+
+	fromFile := f.Path
+	var existingLines []string
+	if os.IsNotExist(err) {
+		fromFile = "/dev/null"
+	} else {
+		existingLines = difflib.SplitLines(string(existing))
+	}
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        existingLines,
+		B:        difflib.SplitLines(string(f.Code)),
+		FromFile: fromFile,
+		ToFile:   f.Path + " (generated)",
+		Context:  3,
+	})
+	if err != nil {
+		return fmt.Errorf("diffing %s: %s", f.Path, err)
+	}
+	return StaleError{Path: f.Path, Diff: diff}
+}
+
+// WriteFiles writes all files, returning their paths.
+func WriteFiles(files []File) (paths []string, err error) {
+	for _, f := range files {
+		err := f.Write()
+		if err != nil {
+			return paths, err
+		}
+		paths = append(paths, f.Path)
+	}
+	return paths, nil
+}
+
+// CheckFiles checks all files, as File.Check does. If any are stale, it returns
+// an Errors with a StaleError for each of them.
+func CheckFiles(files []File) error {
+	var errs []error
+	for _, f := range files {
+		err := f.Check()
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return Errors{fmt.Errorf("%d of %d generated files are stale or can't be checked", len(errs), len(files)), errs}
+	}
+	return nil
+}
+
+// A StaleError is returned when a generated file on disk doesn't match the
+// code that would be generated for it now.
+type StaleError struct {
+	Path string
+	// Diff is a unified diff from the file on disk to the generated code.
+	Diff string
+}
+
+// Error implements error.
+func (err StaleError) Error() string {
+	return fmt.Sprintf("%s is stale; regenerate it:\n%s", err.Path, err.Diff)
+}
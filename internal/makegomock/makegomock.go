@@ -3,99 +3,878 @@ package makegomock
 import (
 	"bytes"
 	"fmt"
+	"go/ast"
+	"go/build/constraint"
+	"go/format"
+	"go/parser"
+	"go/scanner"
+	"go/token"
 	"go/types"
 	"io"
 	"os"
 	"path"
 	"path/filepath"
+	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 	"text/tabwriter"
+	"unicode"
 
+	"golang.org/x/mod/modfile"
 	"golang.org/x/tools/go/packages"
 )
 
-// GenerateToFileFromFile is a convenience function that combines Resolve,
-// Generate and operations on the file system to write the generated mock code
-// to a file.
-func GenerateToFileFromFile(
+// generatedHeader marks the files generated by make.go.mock.
+const generatedHeader = "// Code generated by github.com/tcard/make.go.mock. DO NOT EDIT."
+
+// mockrtImportPath is the import path of the package that mocks generated with
+// Options.Runtime depend on.
+const mockrtImportPath = "github.com/tcard/make.go.mock/mockrt"
+
+// Options are the settings shared by all mocks generated in a run, other than
+// which types to mock and where.
+type Options struct {
+	// Dir is the directory where packages are loaded from, and which relative
+	// source and destination paths are relative to. If empty, it's the current
+	// directory. Generated file paths are still relative to the current
+	// directory.
+	Dir string
+	// Tags are comma-separated build tags to load packages with, as in go
+	// build -tags.
+	Tags string
+	// Bare disables the declarative descriptors.
+	Bare bool
+	// BuildTag is a build constraint expression, like "integration && linux",
+	// to restrict the generated files to.
+	BuildTag string
+	// Header is text, like a license notice, to put in a comment at the top of
+	// each generated file.
+	Header string
+	// Assert adds to each mock a compile-time assertion that it still matches
+	// the original type, importing the latter's package if needed.
+	Assert bool
+	// Runtime makes the descriptors delegate matching and counting calls to
+	// package mockrt, instead of generating the code to do it in each mock.
+	Runtime bool
+}
+
+// FilesFromFile generates mocks for the targets, declared in the package named
+// srcPkgName containing the Go file at srcPath, as set by go generate in
+// GOFILE and GOPACKAGE. It returns the generated files without writing them.
+//
+// If dstFileOrDirPath is a path to a Go source file, all mocks are generated
+// into that file. Otherwise, each mock gets its own file in the destination
+// directory, named as Resolve does. It's relative to the directory of the file
+// at srcPath.
+func FilesFromFile(
 	dstFileOrDirPath,
 	dstPkgName,
-	dstTypeName,
 	srcPath,
-	srcPkgName,
-	srcTypeName string,
-	bare bool,
-) (dstFilePath string, err error) {
+	srcPkgName string,
+	targets []Target,
+	opts Options,
+) ([]File, error) {
+	pkg, _, err := loadFromFile(srcPath, srcPkgName, "", opts)
+	if err != nil {
+		return nil, err
+	}
+	return generateFiles(dstFileOrDirPath, dstPkgName, fileDir(srcPath, opts), pkg, pkg, targets, opts)
+}
+
+// FilesFromImportPath is like FilesFromFile, but the types to mock are looked
+// up in the package at srcImportPath, which can be any package resolvable from
+// the one containing the Go file at curPath, named curPkgName.
+//
+// The destination is still resolved relative to the package containing the
+// file at curPath. If it's a different package than the one at srcImportPath,
+// the generated code imports the latter as needed.
+func FilesFromImportPath(
+	dstFileOrDirPath,
+	dstPkgName,
+	curPath,
+	curPkgName,
+	srcImportPath string,
+	targets []Target,
+	opts Options,
+) ([]File, error) {
+	if srcImportPath == "" {
+		return nil, fmt.Errorf("expected non-empty source import path")
+	}
+	curPkg, srcPkg, err := loadFromFile(curPath, curPkgName, srcImportPath, opts)
+	if err != nil {
+		return nil, err
+	}
+	return generateFiles(dstFileOrDirPath, dstPkgName, fileDir(curPath, opts), curPkg, srcPkg, targets, opts)
+}
+
+// fileDir returns the directory of the file at path, which is relative to
+// opts.Dir unless it's absolute.
+func fileDir(path string, opts Options) string {
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(opts.Dir, path)
+	}
+	return filepath.Dir(path)
+}
+
+// FilesFromPackage is like FilesFromFile, but doesn't depend on being run by
+// go generate: the types to mock are looked up in the single package matched
+// by srcPattern, which can be a directory, like ./internal/store, or an import
+// path.
+//
+// dstFileOrDirPath is relative to opts.Dir. If empty, the mocks are generated
+// into the package's directory.
+func FilesFromPackage(
+	dstFileOrDirPath,
+	dstPkgName,
+	srcPattern string,
+	targets []Target,
+	opts Options,
+) ([]File, error) {
 	pkgs, err := packages.Load(&packages.Config{
-		Mode: packages.LoadTypes,
-	}, "file="+srcPath)
+		Mode:       loadMode,
+		Dir:        opts.Dir,
+		BuildFlags: buildFlags(opts.Tags),
+	}, srcPattern)
 	if err != nil {
-		return "", fmt.Errorf("loading Go file at %s: %s", srcPath, err)
+		return nil, fmt.Errorf("loading package %s: %s", srcPattern, err)
+	}
+	if len(pkgs) != 1 {
+		return nil, fmt.Errorf("expected %s to match a single package; matched %d", srcPattern, len(pkgs))
+	}
+	return generateFilesInPackage(dstFileOrDirPath, dstPkgName, opts.Dir, pkgs[0], targets, opts)
+}
+
+// buildFlags returns the flags for the go command to load packages with the
+// comma-separated build tags.
+func buildFlags(tags string) []string {
+	if tags == "" {
+		return nil
+	}
+	return []string{"-tags=" + tags}
+}
+
+// generateFilesInPackage generates mocks for the targets in pkg, with
+// destination relative to dstRelativeTo, or the current directory if empty.
+func generateFilesInPackage(
+	dstFileOrDirPath,
+	dstPkgName,
+	dstRelativeTo string,
+	pkg *packages.Package,
+	targets []Target,
+	opts Options,
+) ([]File, error) {
+	if errs := packageErrors(pkg); len(errs) > 0 {
+		return nil, Errors{fmt.Errorf("loading package %s failed", pkg.ID), errs}
+	}
+	if len(pkg.GoFiles) == 0 {
+		return nil, fmt.Errorf("no Go files in package %s", pkg.ID)
+	}
+	if dstFileOrDirPath == "" && (pkg.Module == nil || !pkg.Module.Main) {
+		// Like the standard library, or a dependency in the module cache.
+		return nil, fmt.Errorf("package %s is not in the main module, so mocks can't be generated into its directory; pass a destination", pkg.ID)
+	}
+
+	wd, err := os.Getwd()
+	if err != nil {
+		return nil, err
+	}
+	pkgDir := filepath.Dir(pkg.GoFiles[0])
+	baseDir, err := filepath.Rel(wd, pkgDir)
+	if err != nil {
+		baseDir = pkgDir
+	}
+	if dstFileOrDirPath != "" && dstFileOrDirPath != StdoutPath {
+		if !filepath.IsAbs(dstFileOrDirPath) {
+			dstFileOrDirPath = filepath.Join(dstRelativeTo, dstFileOrDirPath)
+		}
+		absDst, err := filepath.Abs(dstFileOrDirPath)
+		if err != nil {
+			return nil, err
+		}
+		dstFileOrDirPath, err = filepath.Rel(pkgDir, absDst)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return generateFiles(dstFileOrDirPath, dstPkgName, baseDir, pkg, pkg, targets, opts)
+}
+
+// loadFromFile loads the package named curPkgName containing the Go file at
+// curPath and, in the same load, the package at srcImportPath, if not empty.
+// Otherwise, srcPkg is curPkg.
+//
+// If curPath is a test file, curPkg is the package it's compiled into for
+// tests, along with the rest of test files: either the package under test or
+// its external test package, depending on curPkgName.
+func loadFromFile(curPath, curPkgName, srcImportPath string, opts Options) (curPkg, srcPkg *packages.Package, err error) {
+	filePath := curPath
+	if !filepath.IsAbs(filePath) {
+		filePath = filepath.Join(opts.Dir, filePath)
+	}
+	patterns := []string{"file=" + curPath}
+	if srcImportPath != "" {
+		patterns = append(patterns, srcImportPath)
+	}
+	pkgs, err := packages.Load(&packages.Config{
+		Mode:       loadMode,
+		Dir:        opts.Dir,
+		BuildFlags: buildFlags(opts.Tags),
+		Tests:      isTestFile(curPath),
+	}, patterns...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("loading Go file at %s: %s", curPath, err)
 	}
 
-	var srcPkg *packages.Package
 	for _, p := range pkgs {
-		if p.Name == srcPkgName {
-			srcPkg = p
+		if p.Name == curPkgName && (curPkg == nil || hasGoFile(p, filePath)) {
+			curPkg = p
 		}
 	}
-	if srcPkg == nil {
-		return "", fmt.Errorf("didn't load package %q", srcPkgName)
+	if curPkg == nil {
+		return nil, nil, fmt.Errorf("didn't load package %q", curPkgName)
+	}
+	if errs := packageErrors(curPkg); len(errs) > 0 {
+		return nil, nil, Errors{fmt.Errorf("loading package %q failed", curPkgName), errs}
+	}
+
+	srcPkg = curPkg
+	if srcImportPath != "" {
+		// With tests, the package under test is loaded along its test
+		// variants, which have the same import path.
+		srcPkg = nil
+		for _, p := range pkgs {
+			if p.PkgPath == srcImportPath && !isTestVariant(p) {
+				srcPkg = p
+			}
+		}
+		if srcPkg == nil {
+			return nil, nil, fmt.Errorf("didn't load package %q", srcImportPath)
+		}
+	}
+	if errs := packageErrors(srcPkg); len(errs) > 0 {
+		return nil, nil, Errors{fmt.Errorf("loading package %q failed", srcImportPath), errs}
+	}
+
+	return curPkg, srcPkg, nil
+}
+
+// generateFiles generates mocks for the targets in srcPkg, with destination
+// resolved relative to curPkg, whose directory is baseDir.
+func generateFiles(
+	dstFileOrDirPath,
+	dstPkgName,
+	baseDir string,
+	curPkg,
+	srcPkg *packages.Package,
+	targets []Target,
+	opts Options,
+) ([]File, error) {
+	targets, skipped := expandTargets(srcPkg, targets)
+	if len(targets) == 0 {
+		if len(skipped) > 0 {
+			return nil, Errors{fmt.Errorf("no types to mock"), skipped}
+		}
+		return nil, fmt.Errorf("no types to mock")
 	}
-	if len(srcPkg.Errors) > 0 {
+
+	src := newSourceInfo(srcPkg)
+	targetTypes := make([]TargetType, 0, len(targets))
+	for _, target := range targets {
+		var typ *types.Named
+		var fn *funcTarget
+		var field *fieldTarget
+		var err error
+		if target.Func {
+			typ, fn, err = lookupFunc(srcPkg.Types, target.Type)
+		} else {
+			typ, field, err = lookupType(srcPkg.Types, target.Type)
+		}
 		if err != nil {
-			errs := make([]error, 0, len(srcPkg.Errors))
-			for _, err := range srcPkg.Errors {
-				errs = append(errs, err)
+			return nil, err
+		}
+		if hasInvalidTypes(typ) {
+			// Errors that packageErrors left out weren't harmless after
+			// all.
+			if errs := skippedPackageErrors(srcPkg); len(errs) > 0 {
+				return nil, Errors{fmt.Errorf("loading package %s failed", srcPkg.ID), errs}
 			}
-			return "", Errors{fmt.Errorf("loading package %q failed", srcPkgName), errs}
+			return nil, fmt.Errorf("type %s refers to invalid types", typ.Obj().Name())
+		}
+		rename := target.Rename
+		if rename == "" {
+			// For aliases, that's not the name of the type they stand for.
+			rename = targetBaseName(target)
 		}
+		targetTypes = append(targetTypes, TargetType{
+			Type:           typ,
+			Rename:         rename,
+			Interface:      target.Interface,
+			Methods:        target.Methods,
+			ExcludeMethods: target.ExcludeMethods,
+			src:            src,
+			fn:             fn,
+			field:          field,
+		})
 	}
 
-	var dstImportPath string
-	dstFilePath, dstPkgName, dstImportPath, dstTypeName, err = Resolve(
-		dstFileOrDirPath,
-		dstPkgName,
-		dstTypeName,
-		srcPkgName,
-		srcPkg.PkgPath,
-		srcTypeName,
-	)
-	if err != nil {
-		return "", err
+	type dstFile struct {
+		path, pkgName, importPath string
+		targets                   []TargetType
+	}
+	var dstFiles []*dstFile
+	byPath := map[string]*dstFile{}
+	oneFile := filepath.Ext(dstFileOrDirPath) == ".go"
+	toStdout := dstFileOrDirPath == StdoutPath
+	if toStdout {
+		// Package name and import path are resolved as for the default
+		// destination.
+		dstFileOrDirPath = ""
+		oneFile = true
 	}
 
-	typ, ok := srcPkg.Types.Scope().Lookup(srcTypeName).(*types.TypeName)
-	if !ok {
-		return "", fmt.Errorf("type %q not found in package %q", srcTypeName, srcPkgName)
+	for i, target := range targets {
+		dstFilePath, dstPkgName, dstImportPath, _, err := resolve(
+			baseDir,
+			dstFileOrDirPath,
+			dstPkgName,
+			target.Rename,
+			curPkg.Name,
+			curPkg.PkgPath,
+			targetBaseName(target),
+		)
+		if err != nil {
+			return nil, err
+		}
+		obj := targetTypes[i].Type.Obj()
+		if obj.Pkg().Name() == dstPkgName && obj.Pkg().Path() == dstImportPath+"_test" {
+			// An external test package is in the directory of the package
+			// under test, but has its own import path.
+			dstImportPath = obj.Pkg().Path()
+		}
+		err = checkImportable(dstImportPath, obj.Pkg())
+		if err != nil {
+			return nil, err
+		}
+		if isTestFile(srcPkg.Fset.Position(obj.Pos()).Filename) {
+			err = checkTestDst(dstFilePath, dstPkgName, dstImportPath, obj, toStdout)
+			if err != nil {
+				return nil, err
+			}
+		}
+		dstFilePath = filepath.Join(baseDir, dstFilePath)
+		if toStdout {
+			dstFilePath = StdoutPath
+		}
+
+		f, ok := byPath[dstFilePath]
+		if ok && !oneFile {
+			return nil, fmt.Errorf("mocks for both %s and %s would be written to %s; rename one of them", f.targets[0].Type.Obj().Name(), target.Type, dstFilePath)
+		}
+		if !ok {
+			f = &dstFile{path: dstFilePath, pkgName: dstPkgName, importPath: dstImportPath}
+			byPath[dstFilePath] = f
+			dstFiles = append(dstFiles, f)
+		}
+		f.targets = append(f.targets, targetTypes[i])
+	}
+
+	files := make([]File, 0, len(dstFiles))
+	for _, f := range dstFiles {
+		var generated bytes.Buffer
+
+		imports, mocks, err := generateMany(&generated, f.targets, f.pkgName, f.importPath, opts)
+		if err != nil {
+			return nil, fmt.Errorf("generating code: %s", err)
+		}
+
+		files = append(files, File{
+			Path:       f.path,
+			PkgName:    f.pkgName,
+			ImportPath: f.importPath,
+			Code:       generated.Bytes(),
+			Imports:    imports,
+			Mocks:      mocks,
+		})
+	}
+
+	if len(skipped) > 0 {
+		return files, Warnings{skipped}
+	}
+	return files, nil
+}
+
+// A Target is a type to be mocked, as named by the user.
+type Target struct {
+	// Type is the name of the type, or an instantiation of a generic type
+	// like Repository[string,int]. Concrete types can also be named through
+	// a pointer, like *Client. It can also be a path to a struct field,
+	// like Options.OnRetry, to mock the field's type.
+	Type string
+	// Rename is the base name for generated identifiers. If empty, it's
+	// derived from Type.
+	Rename string
+	// Interface, for concrete types, also declares an interface named Rename
+	// with the methods being mocked.
+	Interface bool
+	// Methods, if not empty, are the only methods to mock, and ExcludeMethods
+	// are methods not to mock. The generated mock still has the rest, but
+	// they panic if called.
+	Methods, ExcludeMethods []string
+	// Func makes Type the name of a package-level function, or a method
+	// expression like T.Method or (*T).Method, to be mocked as a function
+	// type with its signature.
+	Func bool
+
+	// All, instead of a single Type, selects every exported interface and
+	// function type in the package, except those declared in generated files.
+	// Types that can't be mocked are skipped, and reported as Warnings.
+	All bool
+	// Include and Exclude, if not nil, filter by name the types selected by
+	// All.
+	Include, Exclude *regexp.Regexp
+}
+
+// AllTarget returns a Target with All set, and Include and Exclude compiled
+// from the given regular expressions, if not empty.
+func AllTarget(include, exclude string) (Target, error) {
+	target := Target{All: true}
+	var err error
+	if include != "" {
+		target.Include, err = regexp.Compile(include)
+		if err != nil {
+			return Target{}, fmt.Errorf("parsing include regexp: %s", err)
+		}
+	}
+	if exclude != "" {
+		target.Exclude, err = regexp.Compile(exclude)
+		if err != nil {
+			return Target{}, fmt.Errorf("parsing exclude regexp: %s", err)
+		}
+	}
+	return target, nil
+}
+
+// expandTargets replaces the targets with All set with the types they select
+// in pkg. The types that were skipped because they can't be mocked are
+// returned as errors.
+func expandTargets(pkg *packages.Package, targets []Target) (expanded []Target, skipped []error) {
+	seen := map[string]struct{}{}
+	for _, target := range targets {
+		if !target.All {
+			seen[typeBaseName(target.Type)] = struct{}{}
+		}
 	}
 
-	var generated bytes.Buffer
+	for _, target := range targets {
+		if !target.All {
+			expanded = append(expanded, target)
+			continue
+		}
+
+		generated := map[string]struct{}{}
+		for _, f := range pkg.Syntax {
+			if ast.IsGenerated(f) {
+				generated[pkg.Fset.Position(f.Package).Filename] = struct{}{}
+			}
+		}
 
-	err = Generate(&generated, typ.Type().(*types.Named), dstPkgName, dstImportPath, dstTypeName, bare)
+		scope := pkg.Types.Scope()
+		for _, name := range scope.Names() {
+			obj, ok := scope.Lookup(name).(*types.TypeName)
+			if !ok || !obj.Exported() || obj.IsAlias() {
+				continue
+			}
+			if target.Include != nil && !target.Include.MatchString(name) ||
+				target.Exclude != nil && target.Exclude.MatchString(name) {
+				continue
+			}
+			if _, ok := seen[name]; ok {
+				continue
+			}
+			if _, ok := generated[pkg.Fset.Position(obj.Pos()).Filename]; ok {
+				continue
+			}
+			named, ok := obj.Type().(*types.Named)
+			if !ok || isConcrete(named) {
+				continue
+			}
+			_, err := inspectType(named, &importsSet{}, nil)
+			if err != nil {
+				skipped = append(skipped, fmt.Errorf("skipping %s: %s", name, err))
+				continue
+			}
+			seen[name] = struct{}{}
+			expanded = append(expanded, Target{Type: name})
+		}
+	}
+	return expanded, skipped
+}
+
+// ParseTargets parses a comma-separated list of types to mock, each of them
+// optionally followed by =Alias to set the base name for its generated
+// identifiers, like:
+//
+//	MyInterface,Repository[string,int]=StringIntRepository
+func ParseTargets(s string) ([]Target, error) {
+	var targets []Target
+	depth := 0
+	start := 0
+	for i := 0; i <= len(s); i++ {
+		if i < len(s) {
+			switch s[i] {
+			case '[':
+				depth++
+				continue
+			case ']':
+				depth--
+				continue
+			case ',':
+				if depth > 0 {
+					continue
+				}
+			default:
+				continue
+			}
+		}
+
+		spec := strings.TrimSpace(s[start:i])
+		start = i + 1
+		if spec == "" {
+			return nil, fmt.Errorf("empty type in list %q", s)
+		}
+		var target Target
+		target.Type = spec
+		if eq := strings.LastIndexByte(spec, '='); eq >= 0 && eq > strings.LastIndexByte(spec, ']') {
+			target.Type = strings.TrimSpace(spec[:eq])
+			target.Rename = strings.TrimSpace(spec[eq+1:])
+			if target.Type == "" || target.Rename == "" {
+				return nil, fmt.Errorf("malformed rename %q", spec)
+			}
+		}
+		targets = append(targets, target)
+	}
+	if depth != 0 {
+		return nil, fmt.Errorf("unbalanced brackets in type list %q", s)
+	}
+	return targets, nil
+}
+
+// ParseMethods parses a comma-separated list of method names, as for
+// Target.Methods.
+func ParseMethods(s string) []string {
+	var names []string
+	for _, name := range strings.Split(s, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// isTestFile tells whether the file at path is only built for tests.
+func isTestFile(path string) bool {
+	return strings.HasSuffix(path, "_test.go")
+}
+
+// isTestVariant tells whether pkg is one of those that the go command builds
+// for tests, like the package under test with its test files, its external
+// test package or the test binary's main package.
+func isTestVariant(pkg *packages.Package) bool {
+	return strings.HasSuffix(pkg.ID, ".test]") || strings.HasSuffix(pkg.ID, ".test")
+}
+
+func hasGoFile(pkg *packages.Package, path string) bool {
+	path, err := filepath.Abs(path)
 	if err != nil {
-		return "", fmt.Errorf("generating code: %s", err)
+		return false
+	}
+	for _, f := range pkg.GoFiles {
+		if f == path {
+			return true
+		}
+	}
+	return false
+}
+
+// lookupType finds the type that expr refers to in pkg's scope.
+//
+// expr is either the name of a type or, for generic types, an instantiation
+// with type arguments, like Repository[string,int]. Concrete types may be
+// prefixed with *, which makes no difference since their mocks always have
+// the pointer's method set.
+//
+// Aliases are resolved to the type they stand for. If that's a function or
+// interface type literal, the returned type is a new one named after the
+// alias, so that the mock is too.
+//
+// expr can also be a path to a field, like Options.OnRetry, through nested
+// structs. The field's type is then returned, or if it's a function or
+// interface type literal, a new one named after the path, and field is not
+// nil.
+func lookupType(pkg *types.Package, expr string) (typ *types.Named, field *fieldTarget, err error) {
+	typeExpr, fields := splitFieldPath(expr)
+	if len(fields) > 0 {
+		return lookupField(pkg, typeExpr, fields)
+	}
+	typ, err = lookupNamedType(pkg, expr)
+	return typ, nil, err
+}
+
+// lookupNamedType is like lookupType, without field paths.
+func lookupNamedType(pkg *types.Package, expr string) (*types.Named, error) {
+	ptr := strings.HasPrefix(expr, "*")
+	expr = strings.TrimSpace(strings.TrimPrefix(expr, "*"))
+	name := typeBaseName(expr)
+	obj, ok := pkg.Scope().Lookup(name).(*types.TypeName)
+	if !ok {
+		return nil, fmt.Errorf("type %q not found in package %q", name, pkg.Name())
+	}
+	typ, err := namedType(obj)
+	if err != nil {
+		return nil, err
+	}
+	if ptr && !isConcrete(typ) {
+		return nil, fmt.Errorf("type %q is a function or interface; mock it without *", name)
+	}
+	if name == expr {
+		return typ, nil
 	}
 
-	dstDir := filepath.Dir(dstFilePath)
-	err = os.MkdirAll(dstDir, 0755)
+	if typ.TypeParams().Len() == 0 {
+		return nil, fmt.Errorf("type %q is not generic; can't instantiate it as %s", name, expr)
+	}
+	tv, err := types.Eval(token.NewFileSet(), pkg, token.NoPos, expr)
 	if err != nil {
-		return "", fmt.Errorf("creating destination directory at %s: %s", dstDir, err)
+		return nil, fmt.Errorf("instantiating %s: %s", expr, err)
+	}
+	inst, ok := tv.Type.(*types.Named)
+	if !tv.IsType() || !ok {
+		return nil, fmt.Errorf("expected %s to be an instantiation of type %q", expr, name)
 	}
+	return inst, nil
+}
+
+// A fieldTarget is a struct field whose type is to be mocked, reached from typ
+// through the path of fields.
+type fieldTarget struct {
+	typ    *types.Named
+	fields []string
+	// literal tells whether the field's type is a type literal, for which a
+	// named type was made, and so can only be referred to by its underlying
+	// type.
+	literal bool
+}
+
+// isLiteral tells whether f is the field of a type literal. f may be nil.
+func (f *fieldTarget) isLiteral() bool {
+	return f != nil && f.literal
+}
 
-	f, err := os.Create(dstFilePath)
+// lookupField finds the type of the field at the end of the path of fields,
+// starting from the type at typeExpr, as lookupType does.
+func lookupField(pkg *types.Package, typeExpr string, fields []string) (*types.Named, *fieldTarget, error) {
+	typ, err := lookupNamedType(pkg, typeExpr)
 	if err != nil {
-		return "", fmt.Errorf("creating destination file at %s: %s", dstFilePath, err)
+		return nil, nil, err
+	}
+	if typ.TypeParams().Len() > 0 && typ.TypeArgs().Len() == 0 {
+		return nil, nil, fmt.Errorf("type %s is generic; instantiate it to mock its fields, like %s[...].%s", typeExpr, typeExpr, strings.Join(fields, "."))
+	}
+
+	path := typeExpr
+	var cur types.Type = typ
+	var v *types.Var
+	for _, name := range fields {
+		obj, _, _ := types.LookupFieldOrMethod(cur, true, pkg, name)
+		var ok bool
+		v, ok = obj.(*types.Var)
+		if !ok || !v.IsField() {
+			return nil, nil, fmt.Errorf("type %s has no field %s", path, name)
+		}
+		path += "." + name
+		cur = v.Type()
+	}
+
+	field := &fieldTarget{typ: typ, fields: fields}
+	t := types.Unalias(v.Type())
+	if named, ok := t.(*types.Named); ok && named.Obj().Pkg() != nil {
+		return named, field, nil
+	}
+	switch t.Underlying().(type) {
+	case *types.Signature, *types.Interface:
+		field.literal = true
+		return types.NewNamed(types.NewTypeName(v.Pos(), pkg, path, nil), t.Underlying(), nil), field, nil
+	}
+	return nil, nil, fmt.Errorf("field %s is of type %s, which can't be mocked", path, t)
+}
+
+// splitFieldPath splits expr into the type it starts with and the path of
+// fields that follows it, if any, like Options and [Retry, OnRetry] for
+// Options.Retry.OnRetry.
+func splitFieldPath(expr string) (typeExpr string, fields []string) {
+	start := strings.LastIndexByte(expr, ']') + 1
+	i := strings.IndexByte(expr[start:], '.')
+	if i < 0 {
+		return expr, nil
+	}
+	i += start
+	for _, f := range strings.Split(expr[i+1:], ".") {
+		fields = append(fields, strings.TrimSpace(f))
+	}
+	return strings.TrimSpace(expr[:i]), fields
+}
+
+// namedType returns the named type declared by obj, resolving it if it's an
+// alias, as lookupType does.
+func namedType(obj *types.TypeName) (*types.Named, error) {
+	if alias, ok := obj.Type().(*types.Alias); ok && alias.TypeParams().Len() > 0 {
+		return nil, fmt.Errorf("type %q is a generic alias, which can't be mocked; mock the type it stands for instead", obj.Name())
+	}
+	typ := types.Unalias(obj.Type())
+	if named, ok := typ.(*types.Named); ok {
+		if named.Obj().Pkg() != nil {
+			return named, nil
+		}
+		// Predeclared, like error.
+		typ = named.Underlying()
 	}
-	defer f.Close()
+	switch typ.(type) {
+	case *types.Signature, *types.Interface:
+		return types.NewNamed(types.NewTypeName(obj.Pos(), obj.Pkg(), obj.Name(), nil), typ, nil), nil
+	}
+	return nil, fmt.Errorf("type %q is an alias for %s, which can't be mocked", obj.Name(), typ)
+}
+
+// A funcTarget is a function, or a method expression, mocked as a function
+// type named after it.
+type funcTarget struct {
+	fn *types.Func
+	// recv is, for method expressions, the receiver type as written, like T
+	// or *T.
+	recv types.Type
+}
+
+// expr returns how the function is referred to from code with the given
+// qualifier.
+func (f *funcTarget) expr(qualifier types.Qualifier) string {
+	if f.recv == nil {
+		if q := qualifier(f.fn.Pkg()); q != "" {
+			return q + "." + f.fn.Name()
+		}
+		return f.fn.Name()
+	}
+	recv := types.TypeString(f.recv, qualifier)
+	if _, ok := f.recv.(*types.Pointer); ok {
+		recv = "(" + recv + ")"
+	}
+	return recv + "." + f.fn.Name()
+}
 
-	_, err = io.Copy(f, &generated)
+// lookupFunc finds the function that expr refers to in pkg's scope, which is
+// either the name of a package-level function or a method expression like
+// T.Method or (*T).Method.
+//
+// It returns a function type with the function's signature, named after it,
+// so that it can be mocked as a named function type. For method expressions,
+// the receiver is the first parameter.
+func lookupFunc(pkg *types.Package, expr string) (*types.Named, *funcTarget, error) {
+	x, err := parser.ParseExpr(expr)
 	if err != nil {
-		return "", fmt.Errorf("writing into %s: %s", dstFilePath, err)
+		return nil, nil, fmt.Errorf("parsing function %s: %s", expr, err)
+	}
+	f := &funcTarget{}
+	switch x := x.(type) {
+	case *ast.Ident:
+		fn, ok := pkg.Scope().Lookup(x.Name).(*types.Func)
+		if !ok {
+			return nil, nil, fmt.Errorf("function %q not found in package %q", x.Name, pkg.Name())
+		}
+		f.fn = fn
+	case *ast.SelectorExpr:
+		tv, err := types.Eval(token.NewFileSet(), pkg, token.NoPos, types.ExprString(x.X))
+		if err != nil || !tv.IsType() {
+			return nil, nil, fmt.Errorf("expected %s to be a method expression, like T.Method", expr)
+		}
+		obj, _, _ := types.LookupFieldOrMethod(tv.Type, true, pkg, x.Sel.Name)
+		fn, ok := obj.(*types.Func)
+		if !ok {
+			return nil, nil, fmt.Errorf("type %s has no method %s", types.ExprString(x.X), x.Sel.Name)
+		}
+		f.fn = fn
+		f.recv = tv.Type
+	default:
+		return nil, nil, fmt.Errorf("expected %s to be the name of a function, or a method expression like T.Method", expr)
+	}
+
+	// Method expressions are checked, and their type built, as the compiler
+	// does.
+	tv, err := types.Eval(token.NewFileSet(), pkg, token.NoPos, expr)
+	if terr, ok := err.(types.Error); ok {
+		// Its position is within expr, so it's of no use.
+		return nil, nil, fmt.Errorf("%s: %s", expr, terr.Msg)
+	} else if err != nil {
+		return nil, nil, fmt.Errorf("%s: %s", expr, err)
+	}
+	sig := tv.Type.(*types.Signature)
+	if sig.TypeParams().Len() > 0 {
+		return nil, nil, fmt.Errorf("function %s is generic, which isn't supported", expr)
 	}
+	name := types.ExprString(x)
+	typ := types.NewNamed(types.NewTypeName(f.fn.Pos(), f.fn.Pkg(), name, nil), sig, nil)
+	return typ, f, nil
+}
 
-	return dstFilePath, nil
+// targetBaseName returns the default base name for the identifiers generated
+// for target.
+func targetBaseName(target Target) string {
+	if !target.Func {
+		// Field paths, like Options.OnRetry, are named like OptionsOnRetry.
+		typeExpr, fields := splitFieldPath(target.Type)
+		return typeBaseName(typeExpr) + strings.Join(fields, "")
+	}
+	// Method expressions, like (*T).Method, are named like TMethod, and
+	// type arguments, like T[string,int].Method, like TStringIntMethod.
+	var b strings.Builder
+	upper := false
+	for _, r := range target.Type {
+		switch {
+		case r == '[' || r == ',':
+			upper = true
+		case r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r):
+			if upper {
+				r = unicode.ToUpper(r)
+				upper = false
+			}
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// typeBaseName returns the name of the type in expr, stripping the pointer
+// and the type arguments of an instantiation if any.
+func typeBaseName(expr string) string {
+	expr = strings.TrimSpace(strings.TrimPrefix(expr, "*"))
+	if i := strings.IndexByte(expr, '['); i >= 0 {
+		return strings.TrimSpace(expr[:i])
+	}
+	return expr
+}
+
+// Warnings is returned along with the generated files when some types were
+// skipped, which didn't prevent the rest from being generated.
+type Warnings struct {
+	Errs []error
+}
+
+func (w Warnings) Error() string {
+	msgs := make([]string, 0, len(w.Errs))
+	for _, err := range w.Errs {
+		msgs = append(msgs, err.Error())
+	}
+	return fmt.Sprintf("generated with warnings: %s", strings.Join(msgs, ";"))
 }
 
 // Errors is an error caused by multiple errors.
@@ -115,9 +894,9 @@ func (errs Errors) Error() string {
 
 // Resolve resolves the destination file path, package name and import path.
 //
-// If dstPkgName is empty, pkgName will be the name of the destination
-// directory, or srcPkgName if the destination directory is the current
-// directory.
+// If dstPkgName is empty, pkgName will be that of the package already in the
+// destination directory, if any, or else the name of the directory, or
+// srcPkgName if the destination directory is the current directory.
 //
 // The destination directory is resolved from dstFileOrDirPath. If empty, the
 // current directory is used. If a path to what looks like a Go source file,
@@ -130,9 +909,10 @@ func (errs Errors) Error() string {
 //
 // If dstTypeName is left empty, srcTypeName is used.
 //
-// The import path is resolved from the destination directory and srcImportPath.
-// The destination directory must be resolved to a path that is under
-// srcImportPath.
+// The import path is resolved from the destination directory by finding the
+// module that contains it, which needn't be the module of srcImportPath. If
+// there's no such module, the destination directory must be under the current
+// directory, whose import path is srcImportPath.
 func Resolve(
 	dstFileOrDirPath,
 	dstPkgName,
@@ -140,14 +920,31 @@ func Resolve(
 	srcPkgName,
 	srcImportPath,
 	srcTypeName string,
+) (filePath, pkgName, importPath, typeName string, err error) {
+	return resolve("", dstFileOrDirPath, dstPkgName, dstTypeName, srcPkgName, srcImportPath, srcTypeName)
+}
+
+// resolve is like Resolve, but with the destination relative to baseDir
+// instead of the current directory.
+func resolve(
+	baseDir,
+	dstFileOrDirPath,
+	dstPkgName,
+	dstTypeName,
+	srcPkgName,
+	srcImportPath,
+	srcTypeName string,
 ) (filePath, pkgName, importPath, typeName string, err error) {
 	typeName = resolveTypeName(dstTypeName, srcTypeName)
 	filePath, err = resolveFilePath(dstFileOrDirPath, typeName)
 	if err != nil {
 		return
 	}
-	pkgName = resolvePkgName(dstPkgName, dstFileOrDirPath, srcPkgName)
-	importPath, err = resolveImportPath(filePath, srcImportPath)
+	pkgName, err = resolvePkgName(baseDir, dstPkgName, filePath, srcPkgName)
+	if err != nil {
+		return
+	}
+	importPath, err = resolveImportPath(baseDir, filePath, srcImportPath)
 	return
 }
 
@@ -167,65 +964,343 @@ func resolveFilePath(dstFileOrDirPath, dstTypeName string) (string, error) {
 	return filepath.Clean(filePath), nil
 }
 
-func resolvePkgName(dstPkgName, dstFileOrDirPath, srcPkgName string) string {
-	pkgName := dstPkgName
-	if pkgName == "" {
-		if dstFileOrDirPath == "" {
-			pkgName = srcPkgName
-		} else {
-			pkgName = filepath.Base(filepath.Dir(dstFileOrDirPath))
-			if pkgName == "." {
-				pkgName = srcPkgName
-			}
+func resolvePkgName(baseDir, dstPkgName, filePath, srcPkgName string) (string, error) {
+	if dstPkgName != "" {
+		return dstPkgName, nil
+	}
+	dstDir := filepath.Dir(filePath)
+	if dstDir == "." {
+		return srcPkgName, nil
+	}
+	// The directory may be relative, like .., so its name is taken from
+	// where it is.
+	absDstDir, err := filepath.Abs(filepath.Join(baseDir, dstDir))
+	if err != nil {
+		return "", err
+	}
+	if pkgName := dirPkgName(absDstDir); pkgName != "" {
+		return pkgName, nil
+	}
+	return assumedPackageName(filepath.ToSlash(absDstDir)), nil
+}
+
+// dirPkgName returns the name of the package declared by the non-test Go files
+// in dir, or an empty string if there's none.
+func dirPkgName(dir string) string {
+	paths, _ := filepath.Glob(filepath.Join(dir, "*.go"))
+	for _, path := range paths {
+		if isTestFile(path) {
+			continue
+		}
+		f, err := parser.ParseFile(token.NewFileSet(), path, nil, parser.PackageClauseOnly)
+		if err == nil {
+			return f.Name.Name
 		}
 	}
-	return pkgName
+	return ""
 }
 
-func resolveImportPath(filePath, srcImportPath string) (string, error) {
+func resolveImportPath(baseDir, filePath, srcImportPath string) (string, error) {
 	dstDir := filepath.Dir(filePath)
+	absDstDir, err := filepath.Abs(filepath.Join(baseDir, dstDir))
+	if err != nil {
+		return "", err
+	}
+	modDir, modPath, err := findModule(absDstDir)
+	if err != nil {
+		return "", err
+	}
+	if modDir != "" {
+		rel, err := filepath.Rel(modDir, absDstDir)
+		if err != nil {
+			return "", err
+		}
+		return path.Join(modPath, filepath.ToSlash(rel)), nil
+	}
+
 	importPath := path.Join(srcImportPath, filepath.ToSlash(dstDir))
-	if !strings.HasPrefix(importPath, srcImportPath) {
-		return "", fmt.Errorf("resolved import path %q from destination directory %s which is not inside import path %q", importPath, dstDir, srcImportPath)
+	if importPath != srcImportPath && !strings.HasPrefix(importPath, srcImportPath+"/") {
+		return "", fmt.Errorf("destination directory %s is not in a module, nor inside import path %q", dstDir, srcImportPath)
 	}
 	return importPath, nil
 }
 
+// findModule finds the module containing dir, which needn't exist, by looking
+// for a go.mod file in it or its parents. If there's none, modDir is empty.
+func findModule(dir string) (modDir, modPath string, err error) {
+	for {
+		data, err := os.ReadFile(filepath.Join(dir, "go.mod"))
+		if err == nil {
+			modPath := modfile.ModulePath(data)
+			if modPath == "" {
+				return "", "", fmt.Errorf("no module path in %s", filepath.Join(dir, "go.mod"))
+			}
+			return dir, modPath, nil
+		}
+		if !os.IsNotExist(err) {
+			return "", "", fmt.Errorf("looking for module at %s: %s", dir, err)
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", "", nil
+		}
+		dir = parent
+	}
+}
+
+// checkImportable returns an error if code in the package at importPath can't
+// import package srcPkg.
+func checkImportable(importPath string, srcPkg *types.Package) error {
+	srcPath := srcPkg.Path()
+	if importPath == srcPath {
+		return nil
+	}
+	if srcPkg.Name() == "main" {
+		return fmt.Errorf("mocks for types in package main must be generated in the same package; %s can't import %s", importPath, srcPath)
+	}
+	// As the go command does, look for the last internal element.
+	var internalParent string
+	switch {
+	case strings.HasSuffix(srcPath, "/internal"):
+		internalParent = strings.TrimSuffix(srcPath, "/internal")
+	case strings.Contains(srcPath, "/internal/"):
+		internalParent = srcPath[:strings.LastIndex(srcPath, "/internal/")]
+	default:
+		return nil
+	}
+	if importPath == internalParent || strings.HasPrefix(importPath, internalParent+"/") {
+		return nil
+	}
+	return fmt.Errorf("%s can't import internal package %s; generate the mocks within %s", importPath, srcPath, internalParent)
+}
+
+// checkTestDst returns an error if the mock for obj, declared in a test file,
+// can't be generated into the file at dstFilePath, in the package named
+// dstPkgName at dstImportPath.
+//
+// Types declared in test files can only be referred to from other test files
+// in the same package or, if not in an external test package already, in its
+// external test package.
+func checkTestDst(dstFilePath, dstPkgName, dstImportPath string, obj *types.TypeName, toStdout bool) error {
+	if !toStdout && !isTestFile(dstFilePath) {
+		return fmt.Errorf("type %s is declared in a test file, so its mock must be generated into a _test.go file, not %s", obj.Name(), dstFilePath)
+	}
+	pkg := obj.Pkg()
+	if dstImportPath == pkg.Path() && (dstPkgName == pkg.Name() || dstPkgName == pkg.Name()+"_test") {
+		return nil
+	}
+	return fmt.Errorf("type %s is declared in a test file, so its mock must be generated in package %s or its external test package", obj.Name(), pkg.Name())
+}
+
 // Generate generates mock code into w that mocks the specified type in a Go
 // source file at the given package name and import path.
 //
 // If rename is empty, the generated type name will be based on typ.
+//
+// If typ is generic and not instantiated, the generated types are generic too,
+// with the same type parameters and constraints as typ. If typ is an
+// instantiation of a generic type, the generated types are concrete.
 func Generate(w io.Writer, typ *types.Named, pkgName, importPath, rename string, bare bool) error {
-	methods, imports, err := inspectType(typ, pkgName, importPath)
-	if err != nil {
-		return err
+	return GenerateMany(w, []TargetType{{Type: typ, Rename: rename}}, pkgName, importPath, bare, "")
+}
+
+// A TargetType is a type to be mocked, resolved from its package.
+type TargetType struct {
+	Type *types.Named
+	// Rename is the base name for generated identifiers. If empty, it's
+	// derived from Type.
+	Rename string
+	// Interface, Methods and ExcludeMethods are as in Target.
+	Interface               bool
+	Methods, ExcludeMethods []string
+
+	// src, if known, provides doc comments and parameter names.
+	src *sourceInfo
+	// fn, if not nil, is the function that Type was made for.
+	fn *funcTarget
+	// field, if not nil, is the field that Type was looked up for.
+	field *fieldTarget
+}
+
+// GenerateMany is like Generate, but generates mocks for several types into
+// the same Go source file, with a single import declaration shared by all of
+// them.
+//
+// If buildTag isn't empty, it's a build constraint expression, like
+// "integration && linux", that the file is restricted to.
+func GenerateMany(w io.Writer, targets []TargetType, pkgName, importPath string, bare bool, buildTag string) error {
+	_, _, err := generateMany(w, targets, pkgName, importPath, Options{Bare: bare, BuildTag: buildTag})
+	return err
+}
+
+// generateMany is like GenerateMany, with settings taken from opts. It returns
+// the import paths of the generated file, and a description of each mock in
+// it.
+func generateMany(w io.Writer, targets []TargetType, pkgName, importPath string, opts Options) (importPaths []string, mocks []Mock, err error) {
+	var header string
+	if opts.Header != "" {
+		header = strings.TrimPrefix(docComment("", opts.Header), "\n") + "\n\n"
+	}
+
+	var buildLine string
+	if opts.BuildTag != "" {
+		expr, err := constraint.Parse("//go:build " + opts.BuildTag)
+		if err != nil {
+			return nil, nil, fmt.Errorf("parsing build tag %q: %s", opts.BuildTag, err)
+		}
+		buildLine = "//go:build " + expr.String() + "\n\n"
+	}
+
+	imports := &importsSet{pkgName: pkgName, importPath: importPath}
+	for _, target := range targets {
+		// The generated declarations have the same type parameters, which
+		// would shadow imports named like them.
+		imports.reserve(typeParamNames(target.Type)...)
+	}
+
+	gens := make([]*generator, 0, len(targets))
+	renames := map[string]struct{}{}
+	for _, target := range targets {
+		g, err := newGenerator(target, imports, opts)
+		if err != nil {
+			return nil, nil, err
+		}
+		if _, ok := renames[g.rename]; ok {
+			return nil, nil, fmt.Errorf("more than one mock named %q; rename one of them", g.rename)
+		}
+		renames[g.rename] = struct{}{}
+		gens = append(gens, g)
+	}
+
+	var body bytes.Buffer
+	for _, g := range gens {
+		g.addImports()
+	}
+	for _, g := range gens {
+		g.w = &body
+		err := g.generate()
+		if err != nil {
+			return nil, nil, err
+		}
 	}
 
-	_, err = io.WriteString(w, `// Code generated by github.com/tcard/make.go.mock. DO NOT EDIT.
+	var src bytes.Buffer
+	_, err = io.WriteString(&src, header+buildLine+generatedHeader+`
 
 package `+pkgName+`
 `)
 	if err != nil {
-		return err
+		return nil, nil, err
+	}
+
+	err = writeImports(&src, imports)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	_, err = io.Copy(&src, &body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	formatted, err := formatSource(src.Bytes())
+	if err != nil {
+		return nil, nil, err
+	}
+	_, err = w.Write(formatted)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, imp := range imports.ordered() {
+		importPaths = append(importPaths, imp.path)
+	}
+	for _, g := range gens {
+		mocks = append(mocks, g.mockInfo())
+	}
+	return importPaths, mocks, nil
+}
+
+// formatSource formats the generated src with gofmt's style. If src doesn't
+// parse, which is a bug in the generator, the error quotes the offending
+// line.
+func formatSource(src []byte) ([]byte, error) {
+	formatted, err := format.Source(src)
+	if err == nil {
+		return formatted, nil
+	}
+	errs, ok := err.(scanner.ErrorList)
+	if !ok || len(errs) == 0 {
+		return nil, fmt.Errorf("formatting generated code: %s", err)
+	}
+	pos := errs[0].Pos
+	lines := bytes.Split(src, []byte("\n"))
+	line := ""
+	if pos.Line > 0 && pos.Line <= len(lines) {
+		line = string(lines[pos.Line-1])
+	}
+	return nil, fmt.Errorf("generated code doesn't parse; this is a bug in make.go.mock: %d:%d: %s\n\t%s", pos.Line, pos.Column, errs[0].Msg, line)
+}
+
+func newGenerator(target TargetType, imports *importsSet, opts Options) (*generator, error) {
+	typ, rename := target.Type, target.Rename
+	allMethods, err := inspectType(typ, imports, target.src)
+	if err != nil {
+		return nil, err
+	}
+	methods, err := selectMethods(typ, allMethods, target.Methods, target.ExcludeMethods)
+	if err != nil {
+		return nil, err
 	}
 
 	name := typ.Obj().Name()
 	if rename == "" {
 		rename = name
 	}
-
-	g := &generator{
-		w:         w,
-		typ:       typ,
-		name:      name,
-		rename:    rename,
-		methods:   methods,
-		imports:   imports,
-		qualifier: imports.qualifier,
-		bare:      bare,
+	concrete := isConcrete(typ)
+	if target.Interface {
+		if !concrete {
+			return nil, fmt.Errorf("type %s is already a function or interface; can't declare an interface for it", name)
+		}
+		if rename == name && typ.Obj().Pkg().Path() == imports.importPath {
+			return nil, fmt.Errorf("declaring interface %s would clash with the original type; rename the mock", name)
+		}
+	}
+	if targs := typ.TypeArgs(); targs.Len() > 0 {
+		// Only used in comments, so it mustn't add imports.
+		qualifier := func(pkg *types.Package) string { return pkg.Name() }
+		argStrs := make([]string, 0, targs.Len())
+		for i := 0; i < targs.Len(); i++ {
+			argStrs = append(argStrs, types.TypeString(targs.At(i), qualifier))
+		}
+		name += "[" + strings.Join(argStrs, ", ") + "]"
 	}
 
-	return g.generate()
+	for _, tparam := range typeParamNames(typ) {
+		if _, ok := typeParamReservedNames[tparam]; ok {
+			return nil, fmt.Errorf("type %s has a type parameter named %s, which would shadow an identifier in the generated code; rename it", typ.Obj().Name(), tparam)
+		}
+	}
+	typeParams, typeArgs := inspectTypeParams(typ, imports)
+
+	return &generator{
+		typ:        typ,
+		name:       name,
+		rename:     rename,
+		typeParams: typeParams,
+		typeArgs:   typeArgs,
+		methods:    methods,
+		allMethods: allMethods,
+		concrete:   concrete,
+		declIface:  target.Interface,
+		imports:    imports,
+		qualifier:  imports.qualifier,
+		bare:       opts.Bare,
+		assert:     opts.Assert,
+		runtime:    opts.Runtime,
+		fn:         target.fn,
+		field:      target.field,
+	}, nil
 }
 
 type generator struct {
@@ -233,99 +1308,341 @@ type generator struct {
 	typ        *types.Named
 	name       string
 	rename     string
+	typeParams string
+	typeArgs   string
 	methods    []method
+	allMethods []method
+	concrete   bool
+	declIface  bool
 	imports    *importsSet
 	qualifier  types.Qualifier
 	cmpPkg     string
 	fmtPkg     string
 	runtimePkg string
-	bare       bool
+	mockrtPkg  string
+	syncPkg    string
+	origType   string
+	// installType is, for function types, the type of the variables that
+	// the mock can be installed into.
+	installType string
+	bare        bool
+	assert      bool
+	runtime     bool
+	fn          *funcTarget
+	field       *fieldTarget
+	// fieldType is, for fields, the type the field is reached from.
+	fieldType string
 }
 
 func (g *generator) generate() error {
-	err := g.generateImports()
+	err := g.generateMockerStruct()
 	if err != nil {
 		return err
 	}
 
-	err = g.generateMockerStruct()
+	if !g.bare {
+		err = g.generateDescribeMethod()
+		if err != nil {
+			return err
+		}
+	}
+
+	err = g.generateMockMethod()
 	if err != nil {
 		return err
 	}
 
-	if !g.bare {
-		err = g.generateDescribeMethod()
+	err = g.generateTypeCopy()
+	if err != nil {
+		return err
+	}
+
+	if g.declIface {
+		err = g.generateInterfaceDecl()
+		if err != nil {
+			return err
+		}
+	}
+
+	if g.assert {
+		err = g.generateAssertion()
 		if err != nil {
 			return err
 		}
 	}
-
-	err = g.generateMockMethod()
-	if err != nil {
-		return err
+
+	return nil
+}
+
+// mockInfo describes the mock that g generates.
+func (g *generator) mockInfo() Mock {
+	methods := make([]string, 0, len(g.methods))
+	for _, m := range g.methods {
+		methods = append(methods, m.name)
+	}
+	return Mock{
+		Type:    g.name,
+		TypePkg: g.typ.Obj().Pkg().Path(),
+		Name:    g.rename,
+		Methods: methods,
+	}
+}
+
+func (g *generator) addImports() {
+	if !g.bare {
+		// cmp is only used to compare arguments.
+		for _, m := range g.methods {
+			if len(m.sig.args) > 0 || m.sig.variadic != nil {
+				g.cmpPkg = g.imports.addIfNotPresent("cmp", "github.com/google/go-cmp/cmp")
+				break
+			}
+		}
+		if g.runtime {
+			g.mockrtPkg = g.imports.addIfNotPresent("mockrt", mockrtImportPath)
+		} else {
+			g.fmtPkg = g.imports.addIfNotPresent("fmt", "fmt")
+			if len(g.methods) > 0 {
+				// runtime is only used to describe methods.
+				g.runtimePkg = g.imports.addIfNotPresent("runtime", "runtime")
+			}
+		}
+	}
+	if _, ok := g.typ.Underlying().(*types.Signature); ok && !g.bare {
+		g.syncPkg = g.imports.addIfNotPresent("sync", "sync")
+		g.installType = g.installTypeString()
 	}
+	if g.assert {
+		g.origType = g.origTypeString()
+		if g.field != nil {
+			g.fieldType = types.TypeString(g.field.typ, g.qualifier)
+		}
+	}
+}
 
-	err = g.generateTypeCopy()
-	if err != nil {
-		return err
+// origTypeString returns the mocked type as referred to from the generated
+// code, with the generated type parameters as type arguments if it's generic.
+// For functions, it's the function instead.
+func (g *generator) origTypeString() string {
+	if g.fn != nil {
+		return g.fn.expr(g.qualifier)
 	}
+	if g.field.isLiteral() {
+		return types.TypeString(g.typ.Underlying(), g.qualifier)
+	}
+	return g.typeString()
+}
 
-	return nil
+// installTypeString returns the type of the variables that a mock for a
+// function type can be installed into: the original type or, for functions,
+// their signature.
+func (g *generator) installTypeString() string {
+	if g.fn != nil || g.field.isLiteral() {
+		return types.TypeString(g.typ.Underlying(), g.qualifier)
+	}
+	return g.typeString()
 }
 
-func (g *generator) generateImports() error {
-	if !g.bare {
-		g.cmpPkg = g.imports.addIfNotPresent("cmp", "github.com/google/go-cmp/cmp")
-		g.fmtPkg = g.imports.addIfNotPresent("fmt", "fmt")
-		g.runtimePkg = g.imports.addIfNotPresent("runtime", "runtime")
+// typeString returns the mocked type as referred to from the generated code,
+// with the generated type parameters as type arguments if it's generic.
+func (g *generator) typeString() string {
+	if g.typeArgs == "" {
+		return types.TypeString(g.typ, g.qualifier)
+	}
+	name := g.typ.Obj().Name()
+	if qualifier := g.qualifier(g.typ.Obj().Pkg()); qualifier != "" {
+		name = qualifier + "." + name
 	}
+	return name + g.typeArgs
+}
 
-	orderedImps := g.imports.ordered()
+func writeImports(w io.Writer, imports *importsSet) error {
+	orderedImps := imports.ordered()
 	if len(orderedImps) == 0 {
 		return nil
 	}
 
-	_, err := io.WriteString(g.w, `
+	_, err := io.WriteString(w, `
 import (`)
 	if err != nil {
 		return err
 	}
 
-	for _, imp := range orderedImps {
+	// As goimports does, standard library packages go first, in their own
+	// group.
+	for i, imp := range orderedImps {
+		if i > 0 && isStdImportPath(orderedImps[i-1].path) && !isStdImportPath(imp.path) {
+			_, err = io.WriteString(w, `
+`)
+			if err != nil {
+				return err
+			}
+		}
 		s := `"` + imp.path + `"`
-		if imp.qualifier != "" {
+		if imp.qualifier != assumedPackageName(imp.path) {
 			s = imp.qualifier + " " + s
 		}
-		_, err = io.WriteString(g.w, `
+		_, err = io.WriteString(w, `
 	`+s)
 		if err != nil {
 			return err
 		}
 	}
 
-	_, err = io.WriteString(g.w, `
+	_, err = io.WriteString(w, `
 )
 `)
 	return err
 }
 
-func inspectType(typ *types.Named, pkgName, importPath string) ([]method, *importsSet, error) {
-	imports := importsSet{pkgName: pkgName, importPath: importPath}
+func inspectType(typ *types.Named, imports *importsSet, src *sourceInfo) ([]method, error) {
+	switch utyp := typ.Underlying().(type) {
+	case *types.Signature:
+		sig := inspectSignature(utyp, nil, typeParamNames(typ), imports)
+		return []method{{name: "Func", sig: sig, doc: src.doc(typ.Obj())}}, nil
+	case *types.Interface:
+		if !utyp.IsMethodSet() {
+			return nil, fmt.Errorf("type %s is a constraint interface, which can't be mocked", typ.Obj().Name())
+		}
+		methods := inspectInterface(typ, utyp, imports, src)
+		return methods, nil
+	default:
+		methods := inspectMethodSet(typ, imports, src)
+		if len(methods) == 0 {
+			return nil, fmt.Errorf("type %s has no exported methods to mock", typ.Obj().Name())
+		}
+		return methods, nil
+	}
+}
+
+// hasInvalidTypes tells whether the signatures of typ's methods, as
+// inspectType finds them, refer to types that failed to type-check.
+func hasInvalidTypes(typ *types.Named) bool {
+	var sigs []types.Type
 	switch utyp := typ.Underlying().(type) {
 	case *types.Signature:
-		sig := inspectSignature(utyp, &imports)
-		return []method{{name: "Func", sig: sig}}, &imports, nil
+		sigs = append(sigs, utyp)
 	case *types.Interface:
-		methods := inspectInterface(utyp, &imports)
-		return methods, &imports, nil
+		for i := 0; i < utyp.NumMethods(); i++ {
+			sigs = append(sigs, utyp.Method(i).Type())
+		}
 	default:
-		return nil, nil, fmt.Errorf("expected type %s (%T) to be a function or interface", typ.Obj().Name(), typ)
+		mset := types.NewMethodSet(types.NewPointer(typ))
+		for i := 0; i < mset.Len(); i++ {
+			if m := mset.At(i).Obj(); m.Exported() {
+				sigs = append(sigs, m.Type())
+			}
+		}
+	}
+	invalid := false
+	for _, sig := range sigs {
+		walkType(sig, func(t types.Type) {
+			if t, ok := t.(*types.Basic); ok && t.Kind() == types.Invalid {
+				invalid = true
+			}
+		})
+	}
+	return invalid
+}
+
+// isConcrete tells whether typ is neither a function nor an interface, and so
+// is mocked through its method set.
+func isConcrete(typ *types.Named) bool {
+	switch typ.Underlying().(type) {
+	case *types.Signature, *types.Interface:
+		return false
+	}
+	return true
+}
+
+// inspectTypeParams returns the type parameter list, as in a type declaration,
+// and the matching type argument list, as in a type instantiation, for a
+// generic, non-instantiated type. Both are empty otherwise.
+func inspectTypeParams(typ *types.Named, imports *importsSet) (params, args string) {
+	tparams := typ.TypeParams()
+	if tparams.Len() == 0 || typ.TypeArgs().Len() > 0 {
+		return "", ""
+	}
+	paramStrs := make([]string, 0, tparams.Len())
+	argStrs := make([]string, 0, tparams.Len())
+	for i := 0; i < tparams.Len(); i++ {
+		tparam := tparams.At(i)
+		name := tparam.Obj().Name()
+		paramStrs = append(paramStrs, name+" "+types.TypeString(tparam.Constraint(), imports.qualifier))
+		argStrs = append(argStrs, name)
+	}
+	return "[" + strings.Join(paramStrs, ", ") + "]", "[" + strings.Join(argStrs, ", ") + "]"
+}
+
+// typeParamNames returns the names of typ's type parameters if it's generic
+// and not instantiated, which the generated code declares too.
+func typeParamNames(typ *types.Named) []string {
+	tparams := typ.TypeParams()
+	if typ.TypeArgs().Len() > 0 {
+		return nil
+	}
+	names := make([]string, 0, tparams.Len())
+	for i := 0; i < tparams.Len(); i++ {
+		names = append(names, tparams.At(i).Obj().Name())
 	}
+	return names
 }
 
 type method struct {
 	name string
 	sig  signature
+	doc  string
+	// unmocked methods are kept only so that the mock implements the
+	// original type; they panic if called. excluded tells whether that's
+	// because they were excluded, rather than not included.
+	unmocked bool
+	excluded bool
+}
+
+// selectMethods marks as unmocked the methods not in include, if not empty,
+// or in exclude. It returns the rest, which are the ones to mock.
+func selectMethods(typ *types.Named, methods []method, include, exclude []string) ([]method, error) {
+	if len(include) == 0 && len(exclude) == 0 {
+		return methods, nil
+	}
+	if _, ok := typ.Underlying().(*types.Signature); ok {
+		return nil, fmt.Errorf("type %s is a function; can't select methods to mock", typ.Obj().Name())
+	}
+
+	byName := make(map[string]*method, len(methods))
+	for i := range methods {
+		byName[methods[i].name] = &methods[i]
+	}
+	for _, names := range [][]string{include, exclude} {
+		for _, name := range names {
+			if _, ok := byName[name]; !ok {
+				return nil, fmt.Errorf("type %s has no method %s to mock", typ.Obj().Name(), name)
+			}
+		}
+	}
+
+	if len(include) > 0 {
+		for i := range methods {
+			methods[i].unmocked = true
+		}
+		for _, name := range include {
+			byName[name].unmocked = false
+		}
+	}
+	for _, name := range exclude {
+		byName[name].unmocked = true
+		byName[name].excluded = true
+	}
+
+	var selected []method
+	for _, m := range methods {
+		if !m.unmocked {
+			selected = append(selected, m)
+		}
+	}
+	if len(selected) == 0 {
+		return nil, fmt.Errorf("no methods of type %s left to mock", typ.Obj().Name())
+	}
+	return selected, nil
 }
 
 type signature struct {
@@ -385,66 +1702,399 @@ func (set *importsSet) addIfNotPresent(qualifier, path string) string {
 	if prev, ok := set.byPath[path]; ok {
 		return prev.qualifier
 	}
+
+	name := qualifier
+	suffix := 0
+	for _, used := set.qualifiers[qualifier]; used; _, used = set.qualifiers[qualifier] {
+		suffix++
+		qualifier = fmt.Sprintf("%s%d", name, suffix)
+	}
+
 	if set.byPath == nil {
 		set.byPath = make(map[string]impor)
 	}
 	set.byPath[path] = impor{qualifier: qualifier, path: path}
+	if set.qualifiers == nil {
+		set.qualifiers = make(map[string]struct{})
+	}
+	set.qualifiers[qualifier] = struct{}{}
 	return qualifier
 }
 
+// reserve marks names as taken, so that imports get other qualifiers.
+func (set *importsSet) reserve(names ...string) {
+	if set.qualifiers == nil {
+		set.qualifiers = make(map[string]struct{})
+	}
+	for _, name := range names {
+		set.qualifiers[name] = struct{}{}
+	}
+}
+
 func (set *importsSet) ordered() []impor {
 	is := make([]impor, 0, len(set.byPath))
 	for _, i := range set.byPath {
 		is = append(is, i)
 	}
 	sort.Slice(is, func(i, j int) bool {
+		if iStd, jStd := isStdImportPath(is[i].path), isStdImportPath(is[j].path); iStd != jStd {
+			return iStd
+		}
 		return strings.Compare(is[i].path, is[j].path) < 0
 	})
 	return is
 }
 
-func inspectSignature(sig *types.Signature, imports *importsSet) signature {
+// isStdImportPath tells whether path is a standard library package's, by
+// the same heuristic as goimports: its first element has no dot.
+func isStdImportPath(path string) bool {
+	first, _, _ := strings.Cut(path, "/")
+	return !strings.Contains(first, ".")
+}
+
+// assumedPackageName returns the package name that goimports assumes for an
+// import path, so that imports with that name can be left unnamed.
+func assumedPackageName(importPath string) string {
+	name := path.Base(importPath)
+	if len(name) > 1 && name[0] == 'v' && strings.Trim(name[1:], "0123456789") == "" {
+		// Major version suffix, like example.com/foo/v2.
+		if dir := path.Dir(importPath); dir != "." {
+			name = path.Base(dir)
+		}
+	}
+	name = strings.TrimPrefix(name, "go-")
+	if i := strings.IndexFunc(name, func(r rune) bool {
+		return !(r == '_' || 'a' <= r && r <= 'z' || 'A' <= r && r <= 'Z' || '0' <= r && r <= '9')
+	}); i >= 0 {
+		name = name[:i]
+	}
+	return name
+}
+
+// inspectSignature inspects sig. If named isn't nil, it's an identical
+// signature whose parameter names are used where sig has none.
+//
+// Names are then made safe to use in generated code, as renameArgs does.
+// typeParams are the names of the type parameters in scope, if any.
+func inspectSignature(sig, named *types.Signature, typeParams []string, imports *importsSet) signature {
+	paramNames, resultNames := signatureNames(sig, named)
 	var s signature
 	params := sig.Params()
 	i := 0
 	for ; i < params.Len(); i++ {
 		param := params.At(i)
 		if i == params.Len()-1 && sig.Variadic() {
-			arg := inspectArg("a", i, param, imports, true)
+			arg := inspectArg(paramNames[i], param, imports, true)
 			s.variadic = &arg
 		} else {
-			s.args = append(s.args, inspectArg("a", i, param, imports, false))
+			s.args = append(s.args, inspectArg(paramNames[i], param, imports, false))
 		}
 	}
 	results := sig.Results()
 	for i := 0; i < results.Len(); i++ {
 		result := results.At(i)
-		s.ret = append(s.ret, inspectArg("r", i, result, imports, false))
+		s.ret = append(s.ret, inspectArg(resultNames[i], result, imports, false))
 	}
+	renameArgs(&s, typeParams, localTypeNames(sig, imports), imports)
 	return s
 }
 
-func inspectArg(defaultPrefix string, i int, arg *types.Var, imports *importsSet, variadic bool) argument {
+// localTypeNames returns the names of the types in sig that generated code
+// refers to unqualified, since they're declared in the package it's generated
+// into.
+func localTypeNames(sig *types.Signature, imports *importsSet) map[string]struct{} {
+	names := map[string]struct{}{}
+	add := func(obj *types.TypeName) {
+		if pkg := obj.Pkg(); pkg != nil && pkg.Path() == imports.importPath && pkg.Name() == imports.pkgName {
+			names[obj.Name()] = struct{}{}
+		}
+	}
+	walkType(sig, func(t types.Type) {
+		switch t := t.(type) {
+		case *types.Named:
+			add(t.Obj())
+		case *types.Alias:
+			add(t.Obj())
+		}
+	})
+	return names
+}
+
+// walkType calls f for t and each type it's made of, as spelled out in its
+// type literal. For named types and aliases, that's their type arguments, not
+// the types they stand for.
+func walkType(t types.Type, f func(types.Type)) {
+	var walk func(t types.Type)
+	walkTuple := func(tuple *types.Tuple) {
+		for i := 0; i < tuple.Len(); i++ {
+			walk(tuple.At(i).Type())
+		}
+	}
+	walkTypeArgs := func(targs *types.TypeList) {
+		for i := 0; i < targs.Len(); i++ {
+			walk(targs.At(i))
+		}
+	}
+	walk = func(t types.Type) {
+		f(t)
+		switch t := t.(type) {
+		case *types.Named:
+			walkTypeArgs(t.TypeArgs())
+		case *types.Alias:
+			walkTypeArgs(t.TypeArgs())
+		case *types.Pointer:
+			walk(t.Elem())
+		case *types.Slice:
+			walk(t.Elem())
+		case *types.Array:
+			walk(t.Elem())
+		case *types.Chan:
+			walk(t.Elem())
+		case *types.Map:
+			walk(t.Key())
+			walk(t.Elem())
+		case *types.Signature:
+			walkTuple(t.Params())
+			walkTuple(t.Results())
+		case *types.Struct:
+			for i := 0; i < t.NumFields(); i++ {
+				walk(t.Field(i).Type())
+			}
+		case *types.Interface:
+			for i := 0; i < t.NumExplicitMethods(); i++ {
+				walk(t.ExplicitMethod(i).Type())
+			}
+			for i := 0; i < t.NumEmbeddeds(); i++ {
+				walk(t.EmbeddedType(i))
+			}
+		}
+	}
+	walk(t)
+}
+
+// reservedNames are identifiers that generated code uses where the parameters
+// and results of mocked methods are in scope, and so mustn't be shadowed.
+var reservedNames = map[string]struct{}{
+	"allErrs":       {},
+	"args":          {},
+	"calls":         {},
+	"d":             {},
+	"desc":          {},
+	"errMsgs":       {},
+	"m":             {},
+	"matching":      {},
+	"matchingLines": {},
+	"opts":          {},
+	"prev":          {},
+	"specErrs":      {},
+
+	// Imported by the generator after types have been inspected.
+	"cmp":     {},
+	"fmt":     {},
+	"mockrt":  {},
+	"runtime": {},
+	"sync":    {},
+}
+
+// typeParamReservedNames are identifiers that generated code declares where
+// the type parameters of the mocked type are in scope, which is all over the
+// declarations for its mock. Those for imports are left out, as imports are
+// renamed instead.
+var typeParamReservedNames = map[string]struct{}{
+	"allErrs":       {},
+	"arg":           {},
+	"args":          {},
+	"assert":        {},
+	"atAssert":      {},
+	"calls":         {},
+	"d":             {},
+	"desc":          {},
+	"diff":          {},
+	"err":           {},
+	"errMsgs":       {},
+	"errs":          {},
+	"f":             {},
+	"file":          {},
+	"got":           {},
+	"i":             {},
+	"line":          {},
+	"m":             {},
+	"match":         {},
+	"matching":      {},
+	"matchingErrs":  {},
+	"matchingLines": {},
+	"method":        {},
+	"named":         {},
+	"ok":            {},
+	"opts":          {},
+	"prev":          {},
+	"specErrs":      {},
+	"times":         {},
+}
+
+// validatorArgPrefix is prepended to parameter names for the arguments of
+// argument validators, which are in scope along with the original names.
+const validatorArgPrefix = "got_"
+
+// renameArgs renames the parameters and results in s so that generated code
+// using them compiles and means what it should.
+//
+// Unnamed and blank ones get synthetic names, like a0 for the first parameter
+// and r0 for the first result. Names that are repeated, or that would shadow
+// reserved names, predeclared identifiers, package qualifiers, type parameters
+// or the localTypes that argument and result types refer to, are suffixed with
+// underscores until they don't. Names conflicting with validator arguments are
+// replaced with synthetic ones.
+//
+// Renaming is deterministic, and names that need no renaming are kept, even if
+// others are renamed to avoid them.
+func renameArgs(s *signature, typeParams []string, localTypes map[string]struct{}, imports *importsSet) {
+	type slot struct {
+		arg       *argument
+		synthetic string
+	}
+	var slots []slot
+	for i := range s.args {
+		slots = append(slots, slot{&s.args[i], fmt.Sprintf("a%d", i)})
+	}
+	if s.variadic != nil {
+		slots = append(slots, slot{s.variadic, fmt.Sprintf("a%d", len(s.args))})
+	}
+	for i := range s.ret {
+		slots = append(slots, slot{&s.ret[i], fmt.Sprintf("r%d", i)})
+	}
+
+	avoid := func(name string) bool {
+		if token.IsKeyword(name) || types.Universe.Lookup(name) != nil {
+			return true
+		}
+		if _, ok := reservedNames[name]; ok {
+			return true
+		}
+		if _, ok := imports.qualifiers[name]; ok {
+			return true
+		}
+		if _, ok := localTypes[name]; ok {
+			return true
+		}
+		for _, tparam := range typeParams {
+			if name == tparam {
+				return true
+			}
+		}
+		return false
+	}
+	blank := func(name string) bool {
+		return name == "" || name == "_" || strings.HasPrefix(name, validatorArgPrefix)
+	}
+
+	used := map[string]struct{}{}
+	keep := make([]bool, len(slots))
+	for i, slot := range slots {
+		name := slot.arg.name
+		if _, ok := used[name]; ok || blank(name) || avoid(name) {
+			continue
+		}
+		used[name] = struct{}{}
+		keep[i] = true
+	}
+	for i, slot := range slots {
+		if keep[i] {
+			continue
+		}
+		name := slot.arg.name
+		if blank(name) {
+			name = slot.synthetic
+		}
+		for {
+			if _, ok := used[name]; !ok && !avoid(name) {
+				break
+			}
+			name += "_"
+		}
+		used[name] = struct{}{}
+		slot.arg.name = name
+	}
+}
+
+// signatureNames returns the names of sig's parameters and results. If they
+// are unnamed in sig, they are taken from named, unless that would repeat
+// some name.
+func signatureNames(sig, named *types.Signature) (params, results []string) {
+	names := func(tuple *types.Tuple) []string {
+		names := make([]string, 0, tuple.Len())
+		for i := 0; i < tuple.Len(); i++ {
+			names = append(names, tuple.At(i).Name())
+		}
+		return names
+	}
+	allNamed := func(names []string) bool {
+		for _, name := range names {
+			if name == "" || name == "_" {
+				return false
+			}
+		}
+		return true
+	}
+
+	params, results = names(sig.Params()), names(sig.Results())
+	if named == nil {
+		return params, results
+	}
+	altParams, altResults := params, results
+	if names := names(named.Params()); !allNamed(params) && allNamed(names) {
+		altParams = names
+	}
+	if names := names(named.Results()); !allNamed(results) && allNamed(names) {
+		altResults = names
+	}
+
+	seen := map[string]struct{}{}
+	for _, name := range append(append([]string(nil), altParams...), altResults...) {
+		if name == "" {
+			continue
+		}
+		if _, ok := seen[name]; ok {
+			return params, results
+		}
+		seen[name] = struct{}{}
+	}
+	return altParams, altResults
+}
+
+func inspectArg(name string, arg *types.Var, imports *importsSet, variadic bool) argument {
 	typ := arg.Type()
 	if variadic {
 		typ = typ.Underlying().(*types.Slice).Elem()
 	}
-	name := arg.Name()
-	if name == "" {
-		name = fmt.Sprintf("%s%d", defaultPrefix, i)
-	}
 	return argument{
 		name: name,
 		typ:  types.TypeString(typ, imports.qualifier),
 	}
 }
 
-func inspectInterface(typ *types.Interface, imports *importsSet) []method {
+func inspectInterface(named *types.Named, typ *types.Interface, imports *importsSet, src *sourceInfo) []method {
 	methods := make([]method, 0, typ.NumMethods())
 	for i := 0; i < typ.NumMethods(); i++ {
 		m := typ.Method(i)
-		typ := inspectSignature(m.Type().(*types.Signature), imports)
-		methods = append(methods, method{m.Name(), typ})
+		sig := inspectSignature(m.Type().(*types.Signature), src.implementation(named, m.Name()), typeParamNames(named), imports)
+		methods = append(methods, method{name: m.Name(), sig: sig, doc: src.doc(m)})
+	}
+	return methods
+}
+
+// inspectMethodSet returns the exported methods of *typ, which include those
+// with value receivers and those promoted from embedded fields.
+func inspectMethodSet(typ *types.Named, imports *importsSet, src *sourceInfo) []method {
+	mset := types.NewMethodSet(types.NewPointer(typ))
+	methods := make([]method, 0, mset.Len())
+	for i := 0; i < mset.Len(); i++ {
+		m := mset.At(i).Obj()
+		if !m.Exported() {
+			continue
+		}
+		sig := inspectSignature(m.Type().(*types.Signature), nil, typeParamNames(typ), imports)
+		methods = append(methods, method{name: m.Name(), sig: sig, doc: src.doc(m)})
 	}
 	return methods
 }
@@ -458,16 +2108,31 @@ func (g *generator) generateMockerStruct() error {
 // declarative manner.`
 	}
 
+	maybeUnmocked := ""
+	if len(g.methods) < len(g.allMethods) {
+		maybeUnmocked = `
+//
+// Only some of the original type's methods are mocked; the rest panic if
+// called.`
+	}
+
 	mockerName := g.rename + "Mocker"
+	kind := "type"
+	switch {
+	case g.fn != nil:
+		kind = "function"
+	case g.field.isLiteral():
+		kind = "the type of field"
+	}
 	_, err := io.WriteString(g.w, `
-// `+mockerName+` builds mocks for type `+g.name+`.
+// `+mockerName+` builds mocks for `+kind+` `+g.name+`.
 //
 // Its fields match the original type's methods. Set those you expect to be
 // called, then call the Mock method to get a mock that implements the original
 // type.
 //
-// If the original type was a function, it is mapped to field Func.`+maybeDescribe+`
-type `+mockerName+` struct {`)
+// If the original type was a function, it is mapped to field Func.`+maybeUnmocked+maybeDescribe+`
+type `+mockerName+g.typeParams+` struct {`)
 	if err != nil {
 		return err
 	}
@@ -482,7 +2147,11 @@ type `+mockerName+` struct {`)
 
 	tw := tabwriter.NewWriter(g.w, 0, 0, 1, ' ', tabwriter.TabIndent|tabwriter.StripEscape)
 	for _, method := range g.methods {
-		_, err := io.WriteString(tw, "\xff\t\xff"+method.name+`	func`+sigStr(method.sig, true)+`
+		doc := strings.TrimPrefix(docComment("\xff\t\xff", method.doc), "\n")
+		if doc != "" {
+			doc += "\n"
+		}
+		_, err := io.WriteString(tw, doc+"\xff\t\xff"+method.name+`	func`+sigStr(method.sig, true)+`
 `)
 		if err != nil {
 			return err
@@ -574,106 +2243,192 @@ func (g *generator) generateMockMethod() error {
 	mockerName := g.rename + "Mocker"
 	mockName := g.rename + "Mock"
 	_, err := io.WriteString(g.w, `
-// Mock returns a mock for `+g.typ.Obj().Name()+` that calls the functions
+// Mock returns a mock for `+g.name+` that calls the functions
 // defined as struct fields in the receiver.`+maybeDescribe+`
-func (m *`+mockerName+`) Mock() `+mockName+` {`)
+func (m *`+mockerName+g.typeArgs+`) Mock() `+mockName+g.typeArgs+` {`)
 	if err != nil {
 		return err
 	}
 
-	switch g.typ.Underlying().(type) {
-	case *types.Signature:
+	if _, ok := g.typ.Underlying().(*types.Signature); ok {
 		_, err := io.WriteString(g.w, `
 	return m.Func
 }
 `)
 		return err
-	case *types.Interface:
-		_, err := io.WriteString(g.w, `
-	return _makegomock_`+g.rename+`MockFromMocker{m}
+	}
+
+	_, err = io.WriteString(g.w, `
+	return _makegomock_`+g.rename+`MockFromMocker`+g.typeArgs+`{m}
 }
 
-type _makegomock_`+g.rename+`MockFromMocker struct {
-	m *`+mockerName+`
+type _makegomock_`+g.rename+`MockFromMocker`+g.typeParams+` struct {
+	m *`+mockerName+g.typeArgs+`
 }
 `)
-		if err != nil {
-			return err
-		}
+	if err != nil {
+		return err
+	}
 
-		for _, m := range g.methods {
-			maybeReturn := ""
-			if len(m.sig.ret) > 0 {
-				maybeReturn = "return "
+	for _, m := range g.allMethods {
+		body := "m.m." + m.name + "(" + argsForCall(m.sig.args, m.sig.variadic, true) + ")"
+		if len(m.sig.ret) > 0 {
+			body = "return " + body
+		}
+		if m.unmocked {
+			hint := "regenerate with it in -methods"
+			if m.excluded {
+				hint = "regenerate without it in -exclude-methods"
 			}
-			_, err := io.WriteString(g.w, `
-func (m _makegomock_`+g.rename+`MockFromMocker) `+m.name+sigStr(m.sig, true)+` {
-	`+maybeReturn+`m.m.`+m.name+`(`+argsForCall(m.sig.args, m.sig.variadic, true)+`)
+			body = "panic(" + strconv.Quote(g.name+"."+m.name+" is not mocked: "+hint) + ")"
+		}
+		_, err := io.WriteString(g.w, `
+func (m _makegomock_`+g.rename+`MockFromMocker`+g.typeArgs+`) `+m.name+sigStr(m.sig, true)+` {
+	`+body+`
 }
 `)
-			if err != nil {
-				return err
-			}
+		if err != nil {
+			return err
 		}
-
-		return nil
 	}
 
-	panic("unreachable")
+	return nil
 }
 
 func (g *generator) generateTypeCopy() error {
 	mockName := g.rename + "Mock"
-	_, err := io.WriteString(g.w, `
-// `+mockName+` is a mock with the same underlying type as `+g.typ.Obj().Name()+`.
+	var doc string
+	switch {
+	case g.fn != nil || g.field.isLiteral():
+		doc = `
+// ` + mockName + ` is a mock with the type of ` + g.name + `.`
+	case g.concrete:
+		doc = `
+// ` + mockName + ` is a mock with the exported methods of *` + g.name + `.
+//
+// It is declared as an interface so that the mock can be used where code
+// depends on such an interface instead of on *` + g.name + ` itself.`
+	default:
+		doc = `
+// ` + mockName + ` is a mock with the same underlying type as ` + g.name + `.
 //
 // It is copied from the original just to avoid introducing a dependency on
-// `+g.typ.Obj().Name()+`'s package.
-type `+mockName+` `)
+// ` + g.name + `'s package.`
+	}
+	_, err := io.WriteString(g.w, doc+`
+type `+mockName+g.typeParams+` `)
 	if err != nil {
 		return err
 	}
 
-	switch typ := g.typ.Underlying().(type) {
-	case *types.Signature:
+	if typ, ok := g.typ.Underlying().(*types.Signature); ok {
 		_, err := io.WriteString(g.w, types.TypeString(typ, g.qualifier)+"\n")
 		return err
+	}
+	return g.writeInterface()
+}
+
+func (g *generator) generateInterfaceDecl() error {
+	origName := g.name
+	if pkg := g.typ.Obj().Pkg(); pkg.Path() != g.imports.importPath {
+		origName = pkg.Name() + "." + origName
+	}
+	_, err := io.WriteString(g.w, `
+// `+g.rename+` has the exported methods of *`+origName+`.
+//
+// Depend on it instead of on *`+origName+` so that `+g.rename+`Mock can take
+// its place.
+type `+g.rename+g.typeParams+` `)
+	if err != nil {
+		return err
+	}
+	return g.writeInterface()
+}
+
+// generateAssertion generates code that fails to compile if the mock no longer
+// matches the original type. It's wrapped in a function so that it's never
+// run, and so that it can have the generated type parameters.
+func (g *generator) generateAssertion() error {
+	mockerName := g.rename + "Mocker"
+	mockName := g.rename + "Mock"
+	var assertion string
+	switch g.typ.Underlying().(type) {
+	case *types.Signature:
+		if g.fn != nil {
+			assertion = `var _ ` + mockName + ` = ` + g.origType
+			break
+		}
+		// Function types can't be assigned to each other, only converted.
+		assertion = `var _ = ` + g.origType + `((*` + mockerName + g.typeArgs + `)(nil).Mock())`
 	case *types.Interface:
-		_, err := io.WriteString(g.w, `interface {`)
-		if err != nil {
-			return err
+		assertion = `var _ ` + g.origType + ` = (*` + mockerName + g.typeArgs + `)(nil).Mock()`
+	default:
+		assertion = `var _ ` + mockName + g.typeArgs + ` = (*` + g.origType + `)(nil)`
+	}
+	origName := g.name
+	if g.concrete {
+		origName = "*" + origName
+	}
+	if g.field != nil {
+		// Checked against the field itself, as its type may change.
+		mock := `(*` + mockerName + `)(nil).Mock()`
+		if _, ok := g.typ.Underlying().(*types.Signature); ok && !g.field.literal {
+			mock = g.origType + `(` + mock + `)`
 		}
-
-		if !typ.Empty() {
-			_, err := io.WriteString(g.w, `
+		path := strings.Join(g.field.fields, ".")
+		assertion = `var o ` + g.fieldType + `
+	o.` + path + ` = ` + mock
+		origName = g.field.typ.Obj().Name() + "." + path
+	}
+	_, err := io.WriteString(g.w, `
+// This fails to compile if `+mockName+` no longer matches
+// `+origName+`, which means that the mock must be regenerated.
+func _`+g.typeParams+`() {
+	`+assertion+`
+}
 `)
-			if err != nil {
-				return err
-			}
-		}
+	return err
+}
 
-		tw := tabwriter.NewWriter(g.w, 0, 0, 1, ' ', tabwriter.TabIndent|tabwriter.StripEscape)
-		for _, m := range g.methods {
-			_, err := io.WriteString(tw, "\xff\t\xff"+m.name+sigStr(m.sig, true))
-			if err != nil {
-				return err
-			}
+// writeInterface writes an interface type literal with the mocked methods.
+func (g *generator) writeInterface() error {
+	_, err := io.WriteString(g.w, `interface {`)
+	if err != nil {
+		return err
+	}
 
-			_, err = io.WriteString(tw, `
+	if len(g.allMethods) > 0 {
+		_, err := io.WriteString(g.w, `
 `)
-			if err != nil {
-				return err
-			}
+		if err != nil {
+			return err
 		}
-		err = tw.Flush()
+	}
+
+	tw := tabwriter.NewWriter(g.w, 0, 0, 1, ' ', tabwriter.TabIndent|tabwriter.StripEscape)
+	for _, m := range g.allMethods {
+		doc := strings.TrimPrefix(docComment("\xff\t\xff", m.doc), "\n")
+		if doc != "" {
+			doc += "\n"
+		}
+		_, err := io.WriteString(tw, doc+"\xff\t\xff"+m.name+sigStr(m.sig, true))
 		if err != nil {
 			return err
 		}
 
-		_, err = io.WriteString(g.w, `}
+		_, err = io.WriteString(tw, `
 `)
-
+		if err != nil {
+			return err
+		}
+	}
+	err = tw.Flush()
+	if err != nil {
 		return err
 	}
-	panic("unreachable")
+
+	_, err = io.WriteString(g.w, `}
+`)
+
+	return err
 }
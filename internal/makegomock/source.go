@@ -0,0 +1,135 @@
+package makegomock
+
+import (
+	"go/ast"
+	"go/types"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// loadMode is the mode packages declaring types to mock are loaded with. It
+// includes syntax for doc comments and parameter names; see sourceInfo.
+const loadMode = packages.LoadSyntax | packages.NeedModule
+
+// sourceInfo holds what is known about the declarations in a package from its
+// syntax, which go/types doesn't keep.
+//
+// A nil *sourceInfo is valid, and knows nothing.
+type sourceInfo struct {
+	pkg  *types.Package
+	docs map[types.Object]string
+}
+
+func newSourceInfo(pkg *packages.Package) *sourceInfo {
+	if pkg.Types == nil || pkg.TypesInfo == nil {
+		return nil
+	}
+	src := &sourceInfo{
+		pkg:  pkg.Types,
+		docs: map[types.Object]string{},
+	}
+	add := func(name *ast.Ident, docs ...*ast.CommentGroup) {
+		obj := pkg.TypesInfo.Defs[name]
+		if obj == nil {
+			return
+		}
+		for _, doc := range docs {
+			if text := doc.Text(); text != "" {
+				src.docs[obj] = text
+				return
+			}
+		}
+	}
+	for _, file := range pkg.Syntax {
+		ast.Inspect(file, func(n ast.Node) bool {
+			switch n := n.(type) {
+			case *ast.FuncDecl:
+				add(n.Name, n.Doc)
+			case *ast.GenDecl:
+				for _, spec := range n.Specs {
+					if spec, ok := spec.(*ast.TypeSpec); ok {
+						// A lone type declaration is documented in the
+						// GenDecl.
+						add(spec.Name, spec.Doc, n.Doc)
+					}
+				}
+			case *ast.InterfaceType:
+				for _, field := range n.Methods.List {
+					for _, name := range field.Names {
+						add(name, field.Doc, field.Comment)
+					}
+				}
+			}
+			return true
+		})
+	}
+	return src
+}
+
+// doc returns the doc comment for obj, if it is declared in the package.
+func (src *sourceInfo) doc(obj types.Object) string {
+	if src == nil {
+		return ""
+	}
+	if fn, ok := obj.(*types.Func); ok {
+		obj = fn.Origin()
+	}
+	return src.docs[obj]
+}
+
+// implementation returns the signature of method name in some type declared
+// in the package that implements iface, or nil if there's no such type.
+//
+// It's used to give names to parameters that are unnamed in the interface.
+// Types are tried in alphabetical order, so that the result is stable.
+func (src *sourceInfo) implementation(iface *types.Named, name string) *types.Signature {
+	if src == nil {
+		return nil
+	}
+	utyp, ok := iface.Underlying().(*types.Interface)
+	if !ok || (iface.TypeParams().Len() > 0 && iface.TypeArgs().Len() == 0) {
+		return nil
+	}
+	scope := src.pkg.Scope()
+	for _, n := range scope.Names() {
+		obj, ok := scope.Lookup(n).(*types.TypeName)
+		if !ok || obj.IsAlias() {
+			continue
+		}
+		named, ok := obj.Type().(*types.Named)
+		if !ok || named.TypeParams().Len() > 0 || types.IsInterface(named) {
+			continue
+		}
+		ptr := types.NewPointer(named)
+		if !types.Implements(ptr, utyp) {
+			continue
+		}
+		m, _, _ := types.LookupFieldOrMethod(ptr, false, src.pkg, name)
+		if fn, ok := m.(*types.Func); ok {
+			return fn.Type().(*types.Signature)
+		}
+	}
+	return nil
+}
+
+// docComment formats doc, as returned by ast.CommentGroup.Text, as a line
+// comment, with each line preceded by a newline and indent.
+func docComment(indent, doc string) string {
+	doc = strings.TrimRight(doc, "\n")
+	if doc == "" {
+		return ""
+	}
+	var b strings.Builder
+	for _, line := range strings.Split(doc, "\n") {
+		b.WriteString("\n" + indent + "//")
+		if line == "" {
+			continue
+		}
+		if !strings.HasPrefix(line, "\t") {
+			b.WriteString(" ")
+		}
+		b.WriteString(line)
+	}
+	return b.String()
+}
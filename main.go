@@ -4,47 +4,150 @@
 package main
 
 import (
+	"encoding/json"
 	"flag"
 	"fmt"
 	"os"
 
-	"github.com/tcard/make.go.mock/internal/makegomock"
+	"github.com/tcard/make.go.mock/makegomock"
 )
 
 func main() {
-	typeName := flag.String("type", "", "name of the type to mock")
-	as := flag.String("as", "", "base name for generated identifiers; leave blank for default")
-	dst := flag.String("dst", "", "path of the generated file; pass a dir for default file name; leave black for same dir")
+	typeNames := flag.String("type", "", "comma-separated names of the types to mock, each optionally followed by =Alias to set the base name for its generated identifiers; for generic types, an instantiation like Repository[string,int] is also accepted; concrete types like structs are mocked by their exported methods; a field path like Options.OnRetry mocks the field's type")
+	funcNames := flag.String("func", "", "comma-separated names of package-level functions, or method expressions like T.Method, to mock as function types with their signatures, each optionally followed by =Alias")
+	all := flag.Bool("all", false, "mock every exported interface and function type in the package, except those in generated files; can be combined with -type")
+	include := flag.String("include", "", "with -all, only mock types whose names match this regular expression")
+	exclude := flag.String("exclude", "", "with -all, don't mock types whose names match this regular expression")
+	methods := flag.String("methods", "", "comma-separated names of the only methods to mock; the rest panic if called")
+	excludeMethods := flag.String("exclude-methods", "", "comma-separated names of methods not to mock; they panic if called")
+	as := flag.String("as", "", "base name for generated identifiers; leave blank for default; deprecated: use -type Name=Alias")
+	src := flag.String("src", "", "import path of the package declaring the types to mock; leave blank for the package being generated")
+	pkg := flag.String("pkg", "", "directory or import path of the package declaring the types to mock, for use outside go generate; -dst is then relative to the current directory")
+	dst := flag.String("dst", "", "path of the generated file; pass a dir for one file per type with default names; pass - to write to stdout; leave blank for same dir")
 	dstPkgName := flag.String("dstpkg", "", "package name for the generated file; leave blank to infer")
 	bare := flag.Bool("bare", false, "don't generate the big declarative descriptors, just a bare bones mock")
+	tags := flag.String("tags", "", "comma-separated build tags to load packages with, as in go build -tags")
+	buildTag := flag.String("buildtag", "", "build constraint expression, like integration, to restrict the generated files to with a //go:build line")
+	assert := flag.Bool("assert", false, "add compile-time assertions that the mocks match the original types, importing their package if needed")
+	runtime := flag.Bool("runtime", false, "make the descriptors import github.com/tcard/make.go.mock/mockrt to match and count calls, instead of generating that code in each mock")
+	iface := flag.Bool("iface", false, "for concrete types, also declare an interface named as the mock's base name with the mocked methods")
+	config := flag.String("config", "", "path of a JSON file describing all mocks to generate; other flags except -v, -n and -check are ignored")
+	check := flag.Bool("check", false, "don't write anything; instead, fail with a diff if the generated files aren't up to date")
+	dryRun := flag.Bool("n", false, "don't write anything; instead, print the path, package name and import path of each file that would be generated")
 	verbose := flag.Bool("v", false, "verbose mode")
+	flag.BoolVar(&jsonOutput, "json", false, "print errors and warnings as a stream of JSON objects with file, line, column, kind and message, for editors and other tools")
 	flag.Parse()
 
-	if *typeName == "" {
-		exit("expected non-empty -type")
+	var files []makegomock.File
+	var err error
+	if *config != "" {
+		files, err = makegomock.GenerateFromConfig(*config)
+	} else {
+		if *typeNames == "" && *funcNames == "" && !*all {
+			exit("expected non-empty -type or -func, or -all")
+		}
+		if *pkg == "" && (os.Getenv("GOFILE") == "" || os.Getenv("GOPACKAGE") == "") {
+			exit("GOFILE and GOPACKAGE not set; run from go generate, or pass -pkg to run stand-alone")
+		}
+		files, err = makegomock.Generate(makegomock.Options{
+			Package:   *pkg,
+			GoFile:    os.Getenv("GOFILE"),
+			GoPackage: os.Getenv("GOPACKAGE"),
+			Src:       *src,
+			Tags:      *tags,
+			Types:     targetsFromFlags(*typeNames, *funcNames, *as, *all, *iface, *methods, *excludeMethods),
+			All:       *all,
+			Include:   *include,
+			Exclude:   *exclude,
+			Dst:       *dst,
+			DstPkg:    *dstPkgName,
+			Bare:      *bare,
+			BuildTag:  *buildTag,
+			Assert:    *assert,
+			Runtime:   *runtime,
+		})
+	}
+	if warnings, ok := err.(makegomock.Warnings); ok {
+		printDiagnostics(warnings)
+		err = nil
+	}
+
+	if *dryRun {
+		for _, f := range files {
+			fmt.Printf("%s\t%s\t%s\n", f.Path, f.PkgName, f.ImportPath)
+		}
+		nilOrExit(err, "%s")
+		return
+	}
+
+	if *check {
+		checkErr := makegomock.CheckFiles(files)
+		nilOrExit(err, "%s")
+		nilOrExit(checkErr, "%s")
+		return
 	}
 
-	dstFilePath, err := makegomock.GenerateToFileFromFile(
-		*dst,
-		*dstPkgName,
-		*as,
-		os.Getenv("GOFILE"),
-		os.Getenv("GOPACKAGE"),
-		*typeName,
-		*bare,
-	)
+	dstFilePaths, writeErr := makegomock.WriteFiles(files)
+	printGenerated(dstFilePaths, *verbose)
 	nilOrExit(err, "%s")
+	nilOrExit(writeErr, "%s")
+}
+
+func targetsFromFlags(typeNames, funcNames, as string, all, iface bool, methods, excludeMethods string) []makegomock.Target {
+	if typeNames == "" && (methods != "" || excludeMethods != "") {
+		exit("-methods and -exclude-methods can only be used with -type")
+	}
 
-	if *verbose {
+	var targets []makegomock.Target
+	if typeNames != "" {
+		var err error
+		targets, err = makegomock.ParseTargets(typeNames)
+		nilOrExit(err, "parsing -type: %s")
+		for i := range targets {
+			targets[i].Interface = iface
+			targets[i].Methods = makegomock.ParseMethods(methods)
+			targets[i].ExcludeMethods = makegomock.ParseMethods(excludeMethods)
+		}
+	}
+	if funcNames != "" {
+		funcs, err := makegomock.ParseTargets(funcNames)
+		nilOrExit(err, "parsing -func: %s")
+		for i := range funcs {
+			funcs[i].Func = true
+		}
+		targets = append(targets, funcs...)
+	}
+
+	if as != "" {
+		if len(targets) == 0 {
+			exit("-as can't be used with -all; use -type Name=Alias instead")
+		}
+		if len(targets) > 1 || all {
+			exit("-as can't be used with several types; use -type Name=Alias instead")
+		}
+		targets[0].Rename = as
+	}
+	return targets
+}
+
+func printGenerated(dstFilePaths []string, verbose bool) {
+	if !verbose {
+		return
+	}
+	for _, dstFilePath := range dstFilePaths {
 		fmt.Fprintf(os.Stderr, "generated %s\n", dstFilePath)
 	}
 }
 
+// jsonOutput makes errors and warnings be printed as JSON.
+var jsonOutput bool
+
 func nilOrExit(err error, s string, args ...interface{}) {
 	if err != nil {
-		if errs, ok := err.(makegomock.Errors); ok {
-			for _, err := range errs.Errs {
-				fmt.Fprintln(os.Stderr, err)
+		if errs, ok := err.(makegomock.Errors); ok && len(errs.Errs) > 0 {
+			printDiagnostics(errs)
+			if jsonOutput {
+				os.Exit(1)
 			}
 			err = errs.Err
 		}
@@ -53,6 +156,22 @@ func nilOrExit(err error, s string, args ...interface{}) {
 }
 
 func exit(s string, args ...interface{}) {
-	fmt.Fprintln(os.Stderr, fmt.Errorf(s, args...))
+	printDiagnostics(fmt.Errorf(s, args...))
 	os.Exit(1)
 }
+
+// printDiagnostics prints the diagnostics that err is made of to stderr, in
+// the compiler's style or, with -json, as JSON objects.
+func printDiagnostics(err error) {
+	enc := json.NewEncoder(os.Stderr)
+	for _, d := range makegomock.Diagnostics(err) {
+		switch {
+		case jsonOutput:
+			_ = enc.Encode(d)
+		case d.Kind == "warning":
+			fmt.Fprintf(os.Stderr, "warning: %s\n", d)
+		default:
+			fmt.Fprintln(os.Stderr, d)
+		}
+	}
+}
@@ -0,0 +1,210 @@
+// Package makegomock generates type-safe mocks for Go interfaces and
+// functions, as the make.go.mock command does.
+//
+// It's meant for tools that drive code generation themselves, instead of
+// running the command. A typical use is:
+//
+//	files, err := makegomock.Generate(makegomock.Options{
+//		Package: "./internal/store",
+//		Types:   []makegomock.Target{{Type: "Store"}},
+//		Dst:     "./internal/store/mocks",
+//	})
+//	if err != nil {
+//		return err
+//	}
+//	for _, f := range files {
+//		// f.Path, f.Code, f.Imports, f.Mocks...
+//	}
+//
+// See the make.go.mock command's documentation for details on the generated
+// code.
+package makegomock
+
+import (
+	"fmt"
+
+	impl "github.com/tcard/make.go.mock/internal/makegomock"
+)
+
+// Options describe which types to mock, and how and where to generate their
+// mocks.
+//
+// The types are looked up in the package at Package or, if empty, as when run
+// from go generate, in the package named GoPackage containing GoFile, or in the
+// package at Src if it's not empty.
+type Options struct {
+	// Package is the directory, like ./internal/store, or the import path of
+	// the package declaring the types to mock.
+	Package string
+
+	// GoFile and GoPackage are the path of a Go file and the name of its
+	// package, as set by go generate in GOFILE and GOPACKAGE. They're used if
+	// Package is empty. The destination is relative to that package's
+	// directory.
+	GoFile    string
+	GoPackage string
+	// Src is the import path of the package declaring the types to mock, if
+	// not the one containing GoFile. It must be resolvable from the latter.
+	Src string
+
+	// Dir is the directory Package, GoFile and, with Package, Dst are
+	// relative to, and where packages are loaded from. If empty, it's the
+	// current directory.
+	Dir string
+	// Tags are comma-separated build tags to load packages with, as in go
+	// build -tags.
+	Tags string
+
+	// Types are the types to mock. See ParseTargets for a way to get them
+	// from a string, as the command's -type flag does. Those with Func set
+	// are functions instead, as with the command's -func flag.
+	Types []Target
+	// All also mocks every exported interface and function type in the
+	// package, except those declared in generated files and those already in
+	// Types. If not empty, Include and Exclude are regular expressions that
+	// filter them by name.
+	All     bool
+	Include string
+	Exclude string
+
+	// Dst is the path of the Go file to generate all mocks into or, if it's
+	// not a Go file, of the directory to generate one file per mock into. If
+	// empty, it's the package's directory. If StdoutPath, all mocks are
+	// generated into a single file to be written to standard output.
+	Dst string
+	// DstPkg is the name of the package the generated files belong to. If
+	// empty, it's inferred from Dst.
+	DstPkg string
+
+	// Bare disables the declarative descriptors, generating just a bare bones
+	// mock.
+	Bare bool
+	// BuildTag is a build constraint expression, like "integration && linux",
+	// to restrict the generated files to with a //go:build line.
+	BuildTag string
+	// Header is text, like a license notice, to put in a comment at the top of
+	// each generated file.
+	Header string
+	// Assert adds to each mock a compile-time assertion that it still matches
+	// the original type, so that the generated code fails to build if the
+	// latter changes. The original type's package is imported if needed.
+	Assert bool
+	// Runtime makes the descriptors delegate matching calls to their
+	// descriptions, counting them and reporting failures to package
+	// github.com/tcard/make.go.mock/mockrt, which the generated code then
+	// imports. This makes the generated code smaller, and failure messages
+	// can improve by updating that package, without regenerating the mocks.
+	Runtime bool
+}
+
+// A Target is a type to be mocked, as named in its package.
+type Target = impl.Target
+
+// A File is a generated Go source file, not yet written. Besides its code, it
+// describes where it's to be written and what it contains.
+type File = impl.File
+
+// A Mock describes the code generated for a mocked type.
+type Mock = impl.Mock
+
+// Errors is an error caused by multiple errors.
+type Errors = impl.Errors
+
+// A Diagnostic is an error, like those found while loading packages, with its
+// position in a source file if known.
+type Diagnostic = impl.Diagnostic
+
+// Warnings is returned along with the generated files when some types
+// selected by Options.All were skipped because they can't be mocked.
+type Warnings = impl.Warnings
+
+// A StaleError is returned by File.Check when a generated file on disk doesn't
+// match the code that would be generated for it now.
+type StaleError = impl.StaleError
+
+// StdoutPath is the destination path that makes generated code be written to
+// standard output.
+const StdoutPath = impl.StdoutPath
+
+// Diagnostics flattens err, as returned by this package, into the diagnostics
+// it's made of.
+func Diagnostics(err error) []Diagnostic {
+	return impl.Diagnostics(err)
+}
+
+// ParseTargets parses a comma-separated list of types to mock, each optionally
+// followed by =Alias to set the base name of its generated identifiers, like
+// "Store,Clock=FakeClock".
+func ParseTargets(s string) ([]Target, error) {
+	return impl.ParseTargets(s)
+}
+
+// ParseMethods parses a comma-separated list of method names, as for
+// Target.Methods.
+func ParseMethods(s string) []string {
+	return impl.ParseMethods(s)
+}
+
+// Generate generates the mocks described by opts, returning the generated
+// files without writing them.
+//
+// If err is a Warnings, the files are still complete.
+func Generate(opts Options) ([]File, error) {
+	targets := opts.Types
+	if opts.All {
+		target, err := impl.AllTarget(opts.Include, opts.Exclude)
+		if err != nil {
+			return nil, err
+		}
+		targets = append(targets[:len(targets):len(targets)], target)
+	} else if opts.Include != "" || opts.Exclude != "" {
+		return nil, fmt.Errorf("options Include and Exclude can only be used with All")
+	}
+	if len(targets) == 0 {
+		return nil, fmt.Errorf("expected types to mock, or All")
+	}
+
+	genOpts := impl.Options{
+		Dir:      opts.Dir,
+		Tags:     opts.Tags,
+		Bare:     opts.Bare,
+		BuildTag: opts.BuildTag,
+		Header:   opts.Header,
+		Assert:   opts.Assert,
+		Runtime:  opts.Runtime,
+	}
+	switch {
+	case opts.Package != "":
+		if opts.Src != "" {
+			return nil, fmt.Errorf("options Src and Package can't be used together")
+		}
+		return impl.FilesFromPackage(opts.Dst, opts.DstPkg, opts.Package, targets, genOpts)
+	case opts.GoFile == "" || opts.GoPackage == "":
+		return nil, fmt.Errorf("expected options Package, or GoFile and GoPackage")
+	case opts.Src != "":
+		return impl.FilesFromImportPath(opts.Dst, opts.DstPkg, opts.GoFile, opts.GoPackage, opts.Src, targets, genOpts)
+	}
+	return impl.FilesFromFile(opts.Dst, opts.DstPkg, opts.GoFile, opts.GoPackage, targets, genOpts)
+}
+
+// GenerateFromConfig generates the mocks described by the JSON config file at
+// path, as the command's -config flag does, returning the generated files
+// without writing them.
+//
+// If some mocks fail, the files for the rest are returned along with an
+// Errors.
+func GenerateFromConfig(path string) ([]File, error) {
+	return impl.FilesFromConfig(path)
+}
+
+// WriteFiles writes all files, returning their paths.
+func WriteFiles(files []File) (paths []string, err error) {
+	return impl.WriteFiles(files)
+}
+
+// CheckFiles checks that all files are up to date on disk, without writing
+// anything. If any are stale, it returns an Errors with a StaleError for each
+// of them.
+func CheckFiles(files []File) error {
+	return impl.CheckFiles(files)
+}
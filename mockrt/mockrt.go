@@ -0,0 +1,170 @@
+// Package mockrt holds the bookkeeping shared by mocks generated by
+// make.go.mock with -runtime: matching calls to the described candidates,
+// counting them, and reporting failures.
+//
+// Generated code keeps the typed API to describe mocks, and delegates to this
+// package anything that doesn't depend on the mocked types. It isn't meant to
+// be used directly.
+package mockrt
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+)
+
+// Caller is like runtime.Caller, with skip counted from the function calling
+// it, but returns just the file and line, like file.go:12.
+func Caller(skip int) string {
+	_, file, line, _ := runtime.Caller(skip + 1)
+	return fmt.Sprintf("%s:%d", file, line)
+}
+
+// Times returns a function that accepts exactly times calls.
+func Times(times int) func(int) error {
+	return func(got int) error {
+		if got != times {
+			return fmt.Errorf("expected exactly %d calls, got %d", times, got)
+		}
+		return nil
+	}
+}
+
+// AtLeastTimes returns a function that accepts times or more calls.
+func AtLeastTimes(times int) func(int) error {
+	return func(got int) error {
+		if got < times {
+			return fmt.Errorf("expected at least %d calls, got %d", times, got)
+		}
+		return nil
+	}
+}
+
+// A Mock tracks the calls to the methods of a mock. It's safe for concurrent
+// use.
+type Mock struct {
+	name    string
+	mtx     sync.Mutex
+	methods []*Method
+}
+
+// NewMock returns a Mock for the mocked type with the given name, as shown in
+// failure messages.
+func NewMock(name string) *Mock {
+	return &Mock{name: name}
+}
+
+// Method adds a method to the mock.
+func (m *Mock) Method(name string) *Method {
+	method := &Method{mock: m, name: name}
+	m.methods = append(m.methods, method)
+	return method
+}
+
+// Assert reports to t an error for each candidate that wasn't called the
+// expected number of times. It returns whether there were none.
+func (m *Mock) Assert(t interface {
+	Errorf(s string, args ...interface{})
+}) bool {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	ok := true
+	for _, method := range m.methods {
+		for _, c := range method.candidates {
+			err := c.times(c.calls)
+			if err != nil {
+				ok = false
+				t.Errorf("mock for %s.%s: %s", m.name, method.name, err)
+			}
+		}
+	}
+	return ok
+}
+
+// A Method tracks the calls to a method of a mock, and the candidates that
+// may match them, in the order they were described.
+type Method struct {
+	mock       *Mock
+	name       string
+	candidates []*candidate
+}
+
+type candidate struct {
+	fileLine string
+	times    func(int) error
+	calls    int
+}
+
+// Expect adds a candidate, described at fileLine, which is expected to be
+// called a number of times that times accepts.
+func (m *Method) Expect(fileLine string, times func(int) error) {
+	m.candidates = append(m.candidates, &candidate{fileLine: fileLine, times: times})
+}
+
+// Call starts matching a call to the method with the given arguments. Each
+// candidate must then be checked, in order, before calling Match.
+func (m *Method) Call(args ...interface{}) *Call {
+	return &Call{method: m, args: args}
+}
+
+// A Call is a call to a method of a mock being matched to its candidates.
+type Call struct {
+	method *Method
+	args   []interface{}
+	errs   [][]string
+}
+
+// Check records the errors from validating the call's arguments against the
+// next candidate. If there are none, the candidate matches.
+func (c *Call) Check(errs []string) {
+	c.errs = append(c.errs, errs)
+}
+
+// Match returns the index of the only candidate that matches the call, and
+// counts the call for it.
+//
+// It panics if there's no such candidate, or more than one, explaining why.
+func (c *Call) Match() int {
+	m := c.method
+	name := m.mock.name + "." + m.name
+	if len(m.candidates) == 0 {
+		panic("unexpected call to mock for " + name)
+	}
+
+	var matching []int
+	for i, errs := range c.errs {
+		if len(errs) == 0 {
+			matching = append(matching, i)
+		}
+	}
+	if len(matching) == 1 {
+		m.mock.mtx.Lock()
+		m.candidates[matching[0]].calls++
+		m.mock.mtx.Unlock()
+		return matching[0]
+	}
+
+	var args string
+	for i, arg := range c.args {
+		if i != 0 {
+			args += "\n\t"
+		}
+		args += fmt.Sprintf("%#v", arg)
+	}
+	if len(matching) == 0 {
+		matchingErrs := ""
+		for i, errs := range c.errs {
+			matchingErrs += "\n\tcandidate described at " + m.candidates[i].fileLine + ":\n"
+			for _, err := range errs {
+				matchingErrs += "\n\t\t" + err
+			}
+		}
+		panic(fmt.Errorf("no matching candidate for call to mock for %s with args:\n\n\t%+v\n\nfailing candidates:\n%s", name, args, matchingErrs))
+	}
+	matchingLines := ""
+	for _, i := range matching {
+		matchingLines += "\n\tcandidate described at " + m.candidates[i].fileLine
+	}
+	panic(fmt.Errorf("more than one candidate for call to mock for %s with args:\n\n\t%+v\n\nmatching candidates:\n%s", name, args, matchingLines))
+}